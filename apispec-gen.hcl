@@ -0,0 +1,9 @@
+// Config of tools/apispec-gen. Paths are relative to this file, flags of the same name override them.
+// Run from the repository root with: go -C tools run ./apispec-gen <command>
+
+// fabric-rest-api-specs cloned next to this repository
+specs_path = "../fabric-rest-api-specs"
+
+base_path  = "tools/apispec-gen"
+rules_path = "rules"
+docs_path  = "docs"
@@ -2,6 +2,8 @@
 package main
 
 import (
+	"os"
+
 	"github.com/terraform-linters/tflint-plugin-sdk/plugin"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 
@@ -15,28 +17,61 @@ var (
 )
 
 func main() {
+	// "tflint-ruleset-fabric catalog" prints the generated rule catalog, e.g. to render rule tables or diff releases
+	if len(os.Args) > 1 && os.Args[1] == "catalog" {
+		os.Stdout.Write(apispec.CatalogJSON())
+		return
+	}
+
 	// Combine custom rules with generated rules
 	allRules := []tflint.Rule{
 		// Workspace rules
 		rules.NewFabricWorkspaceCapacity(),
+		rules.NewFabricWorkspaceIdentityRequired(),
 		rules.NewFabricWorkspaceRoleAssignmentRole(),
 
+		// Managed private endpoint rules
+		rules.NewFabricWorkspaceManagedPrivateEndpointTarget(),
+		rules.NewFabricWorkspaceManagedPrivateEndpointRequestMessage(),
+
 		// Role assignment rules
 		rules.NewFabricRoleAssignmentRecommended(),
+		rules.NewFabricRoleAssignmentDuplicate(),
 
 		// Capacity rules
 		rules.NewFabricCapacityRegion(),
 
+		// Gateway rules
+		rules.NewFabricGatewayTypeAttributes(),
+
 		// Item rules
 		rules.NewFabricItemDescriptionRecommended(),
+		rules.NewFabricItemDisplayNameUnique(),
+		rules.NewFabricItemWorkspaceCapacity(),
+		rules.NewFabricItemConfigurationLifecycle(),
+
+		// Eventhouse and KQL database rules
+		rules.NewFabricEventhouseMinimumConsumptionUnits(),
+		rules.NewFabricKQLDatabaseConfiguration(),
+
+		// Warehouse rules
+		rules.NewFabricWarehouseCollationType(),
+		rules.NewFabricWarehouseSnapshotConfiguration(),
+
+		// Folder rules
+		rules.NewFabricFolderHierarchy(),
 
 		// Deployment pipeline rules
 		rules.NewFabricDeploymentPipelineStagesCount(),
 		rules.NewFabricDeploymentPipelineStagesDisplayNameLength(),
 		rules.NewFabricDeploymentPipelineStagesDescriptionLength(),
+		rules.NewFabricDeploymentPipelineStagesSemantics(),
 
 		// Domain rules
 		rules.NewFabricDomainContributorsScope(),
+		rules.NewFabricDomainHierarchy(),
+		rules.NewFabricDomainWorkspaceAssignmentsConflict(),
+		rules.NewFabricDomainRoleAssignmentsPrincipals(),
 
 		// Git integration validation rules
 		rules.NewFabricWorkspaceGitProviderType(),
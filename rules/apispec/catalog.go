@@ -0,0 +1,79 @@
+package apispec
+
+import (
+	_ "embed"
+	"encoding/json"
+)
+
+// catalogJSON lists every generated rule with its constraints and where they came from.
+// It is written by apispec-gen next to the rules, see tools/apispec-gen/README.md.
+//
+//go:embed catalog.json
+var catalogJSON []byte
+
+// CatalogEntry describes a generated rule
+type CatalogEntry struct {
+	Name string `json:"name"`
+	// BlockType is "resource" or "data"
+	BlockType string `json:"block_type"`
+	Resource  string `json:"resource"`
+	// Attribute is the dotted attribute path, empty for cross-attribute constraint rules
+	Attribute   string              `json:"attribute,omitempty"`
+	Severity    string              `json:"severity"`
+	Constraints []CatalogConstraint `json:"constraints"`
+	Provenance  CatalogProvenance   `json:"provenance"`
+}
+
+// CatalogConstraint is one check of a rule, e.g. max_length 256 or one_of ["id", "display_name"]
+type CatalogConstraint struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+	// Source is "mapping", "schema" or "spec"
+	Source string `json:"source"`
+}
+
+// CatalogProvenance records the inputs a rule was generated from
+type CatalogProvenance struct {
+	MappingFile string `json:"mapping_file"`
+	// SpecFile is relative to the fabric-rest-api-specs repository
+	SpecFile   string   `json:"spec_file,omitempty"`
+	SchemaHash string   `json:"schema_hash,omitempty"`
+	Manual     []string `json:"manual,omitempty"`
+}
+
+// Catalog returns the catalog entries of the generated rules, sorted by name
+func Catalog() ([]CatalogEntry, error) {
+	var catalog struct {
+		Rules []CatalogEntry `json:"rules"`
+	}
+	if err := json.Unmarshal(catalogJSON, &catalog); err != nil {
+		return nil, err
+	}
+	return catalog.Rules, nil
+}
+
+// CatalogJSON returns the catalog as generated
+func CatalogJSON() []byte {
+	return catalogJSON
+}
+
+// CatalogEnum returns the allowed values of the enum constraint of the named rule, nil when it has none
+// Hand-written rules use it to check values against the same enums as the generated rules.
+func CatalogEnum(ruleName string) []string {
+	entries, err := Catalog()
+	if err != nil {
+		return nil
+	}
+	for _, entry := range entries {
+		if entry.Name != ruleName {
+			continue
+		}
+		for _, c := range entry.Constraints {
+			var values []string
+			if c.Type == "enum" && json.Unmarshal(c.Value, &values) == nil {
+				return values
+			}
+		}
+	}
+	return nil
+}
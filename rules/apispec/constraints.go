@@ -0,0 +1,230 @@
+package apispec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Constraint rules check attributes against each other. Their paths may point into nested objects,
+// e.g. "credential_details.basic_credentials", read in both forms like in nested.go.
+
+type pathState int
+
+const (
+	pathUnset pathState = iota
+	pathSet
+	// pathUnknown means the path is inside a value that is only known at apply, e.g. target = var.target
+	pathUnknown
+)
+
+// constraintPath is the state of one attribute path in a resource
+type constraintPath struct {
+	state pathState
+	value cty.Value
+	rng   hcl.Range
+}
+
+// constraintViolation is a failed constraint and the range to report it at
+type constraintViolation struct {
+	message string
+	rng     hcl.Range
+}
+
+// constraintChecker evaluates constraints against one resource or data block
+type constraintChecker struct {
+	runner   tflint.Runner
+	resource *hclext.Block
+}
+
+func newConstraintChecker(runner tflint.Runner, resource *hclext.Block) *constraintChecker {
+	return &constraintChecker{runner: runner, resource: resource}
+}
+
+// lookup returns the state of the dotted attribute path
+func (c *constraintChecker) lookup(path string) constraintPath {
+	return c.lookupBody(c.resource.Body, strings.Split(path, "."))
+}
+
+func (c *constraintChecker) lookupBody(body *hclext.BodyContent, path []string) constraintPath {
+	if attr, exists := body.Attributes[path[0]]; exists {
+		return c.lookupExpr(attr.Expr, path[1:])
+	}
+	blocks := body.Blocks.OfType(path[0])
+	if len(blocks) == 0 {
+		return constraintPath{state: pathUnset}
+	}
+	if len(path) == 1 {
+		return constraintPath{state: pathSet, value: cty.DynamicVal, rng: blocks[0].DefRange}
+	}
+	return c.lookupBody(blocks[0].Body, path[1:])
+}
+
+func (c *constraintChecker) lookupExpr(expr hcl.Expression, path []string) constraintPath {
+	if object, ok := expr.(*hclsyntax.ObjectConsExpr); ok && len(path) > 0 {
+		for _, item := range object.Items {
+			if objectKeyName(item.KeyExpr) == path[0] {
+				return c.lookupExpr(item.ValueExpr, path[1:])
+			}
+		}
+		return constraintPath{state: pathUnset}
+	}
+
+	var value cty.Value
+	if err := c.runner.EvaluateExpr(expr, &value, nil); err != nil {
+		if len(path) > 0 {
+			return constraintPath{state: pathUnknown}
+		}
+		// The attribute is written, but its value cannot be evaluated statically
+		return constraintPath{state: pathSet, value: cty.DynamicVal, rng: expr.Range()}
+	}
+
+	for _, name := range path {
+		if !value.IsKnown() {
+			return constraintPath{state: pathUnknown}
+		}
+		if value.IsNull() {
+			return constraintPath{state: pathUnset}
+		}
+		switch {
+		case value.Type().IsObjectType():
+			if !value.Type().HasAttribute(name) {
+				return constraintPath{state: pathUnset}
+			}
+			value = value.GetAttr(name)
+		case value.Type().IsMapType():
+			if !value.HasIndex(cty.StringVal(name)).True() {
+				return constraintPath{state: pathUnset}
+			}
+			value = value.Index(cty.StringVal(name))
+		default:
+			return constraintPath{state: pathUnknown}
+		}
+	}
+
+	if value.IsKnown() && value.IsNull() {
+		return constraintPath{state: pathUnset}
+	}
+	return constraintPath{state: pathSet, value: value, rng: expr.Range()}
+}
+
+// equals reports whether path is set to want; unknown values never match
+func (c *constraintChecker) equals(path string, want string) (constraintPath, bool) {
+	p := c.lookup(path)
+	if p.state != pathSet || !p.value.IsWhollyKnown() || p.value.IsNull() || p.value.Type() != cty.String {
+		return p, false
+	}
+	return p, p.value.AsString() == want
+}
+
+// checkOneOf requires exactly one of paths to be set
+func (c *constraintChecker) checkOneOf(paths []string) []constraintViolation {
+	message := fmt.Sprintf("Exactly one of %s must be set", strings.Join(paths, ", "))
+	set, unknown := c.states(paths)
+	switch {
+	case len(set) > 1:
+		return []constraintViolation{{message: message, rng: set[1].rng}}
+	case len(set) == 0 && !unknown:
+		return []constraintViolation{{message: message, rng: c.resource.DefRange}}
+	}
+	return nil
+}
+
+// checkAtMostOneOf allows at most one of paths to be set
+func (c *constraintChecker) checkAtMostOneOf(paths []string) []constraintViolation {
+	if set, _ := c.states(paths); len(set) > 1 {
+		return []constraintViolation{{message: fmt.Sprintf("Only one of %s can be set", strings.Join(paths, ", ")), rng: set[1].rng}}
+	}
+	return nil
+}
+
+// checkRequiredWith requires every path to be set when attribute is set
+func (c *constraintChecker) checkRequiredWith(paths []string, attribute string) []constraintViolation {
+	trigger := c.lookup(attribute)
+	if trigger.state != pathSet {
+		return nil
+	}
+	var violations []constraintViolation
+	for _, path := range paths {
+		if c.lookup(path).state == pathUnset {
+			violations = append(violations, constraintViolation{message: fmt.Sprintf("%s is required when %s is set", path, attribute), rng: trigger.rng})
+		}
+	}
+	return violations
+}
+
+// checkConflictsWith forbids every path when attribute is set
+func (c *constraintChecker) checkConflictsWith(paths []string, attribute string) []constraintViolation {
+	if c.lookup(attribute).state != pathSet {
+		return nil
+	}
+	var violations []constraintViolation
+	for _, path := range paths {
+		if p := c.lookup(path); p.state == pathSet {
+			violations = append(violations, constraintViolation{message: fmt.Sprintf("%s cannot be set together with %s", path, attribute), rng: p.rng})
+		}
+	}
+	return violations
+}
+
+// checkRequiredIf requires every path to be set when whenAttribute equals whenEquals
+func (c *constraintChecker) checkRequiredIf(paths []string, whenAttribute string, whenEquals string) []constraintViolation {
+	trigger, matches := c.equals(whenAttribute, whenEquals)
+	if !matches {
+		return nil
+	}
+	var violations []constraintViolation
+	for _, path := range paths {
+		if c.lookup(path).state == pathUnset {
+			violations = append(violations, constraintViolation{message: fmt.Sprintf("%s is required when %s is %q", path, whenAttribute, whenEquals), rng: trigger.rng})
+		}
+	}
+	return violations
+}
+
+// checkForbiddenIf forbids every path when whenAttribute equals whenEquals
+func (c *constraintChecker) checkForbiddenIf(paths []string, whenAttribute string, whenEquals string) []constraintViolation {
+	if _, matches := c.equals(whenAttribute, whenEquals); !matches {
+		return nil
+	}
+	var violations []constraintViolation
+	for _, path := range paths {
+		if p := c.lookup(path); p.state == pathSet {
+			violations = append(violations, constraintViolation{message: fmt.Sprintf("%s cannot be set when %s is %q", path, whenAttribute, whenEquals), rng: p.rng})
+		}
+	}
+	return violations
+}
+
+// states returns the set paths in order, and whether any path is unknown
+func (c *constraintChecker) states(paths []string) ([]constraintPath, bool) {
+	var set []constraintPath
+	unknown := false
+	for _, path := range paths {
+		switch p := c.lookup(path); p.state {
+		case pathSet:
+			set = append(set, p)
+		case pathUnknown:
+			unknown = true
+		}
+	}
+	return set, unknown
+}
+
+// emitConstraintViolations reports violations; a custom message replaces them with a single issue
+func emitConstraintViolations(runner tflint.Runner, rule tflint.Rule, violations []constraintViolation, message string) error {
+	if len(violations) > 0 && message != "" {
+		violations = []constraintViolation{{message: message, rng: violations[0].rng}}
+	}
+	for _, violation := range violations {
+		if err := runner.EmitIssue(rule, violation.message, violation.rng); err != nil {
+			return err
+		}
+	}
+	return nil
+}
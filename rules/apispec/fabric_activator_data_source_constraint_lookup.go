@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricActivatorDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricActivatorDataSourceConstraintLookup() *FabricActivatorDataSourceConstraintLookup {
+	return &FabricActivatorDataSourceConstraintLookup{}
+}
+
+func (r *FabricActivatorDataSourceConstraintLookup) Name() string {
+	return "fabric_activator_data_source_constraint_lookup"
+}
+func (r *FabricActivatorDataSourceConstraintLookup) Enabled() bool             { return true }
+func (r *FabricActivatorDataSourceConstraintLookup) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricActivatorDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/reflex/definitions.json"
+}
+
+func (r *FabricActivatorDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_activator" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
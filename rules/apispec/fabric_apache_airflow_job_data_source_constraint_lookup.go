@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricApacheAirflowJobDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricApacheAirflowJobDataSourceConstraintLookup() *FabricApacheAirflowJobDataSourceConstraintLookup {
+	return &FabricApacheAirflowJobDataSourceConstraintLookup{}
+}
+
+func (r *FabricApacheAirflowJobDataSourceConstraintLookup) Name() string {
+	return "fabric_apache_airflow_job_data_source_constraint_lookup"
+}
+func (r *FabricApacheAirflowJobDataSourceConstraintLookup) Enabled() bool { return true }
+func (r *FabricApacheAirflowJobDataSourceConstraintLookup) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricApacheAirflowJobDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/apacheAirflowJob/definitions.json"
+}
+
+func (r *FabricApacheAirflowJobDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_apache_airflow_job" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricApacheAirflowJobInvalidDescription(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "valid value",
+			content: `
+resource "fabric_apache_airflow_job" "example" {
+  description = "example"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "exactly max length 256",
+			content: `
+resource "fabric_apache_airflow_job" "example" {
+  description = "exampleaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "exceeds max length 256",
+			content: `
+resource "fabric_apache_airflow_job" "example" {
+  description = "exampleaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricApacheAirflowJobInvalidDescription(),
+					Message: "description exceeds max length 256",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 276},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricApacheAirflowJobInvalidDescription()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
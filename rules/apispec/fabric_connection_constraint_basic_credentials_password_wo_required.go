@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricConnectionConstraintBasicCredentialsPasswordWoRequired struct{ tflint.DefaultRule }
+
+func NewFabricConnectionConstraintBasicCredentialsPasswordWoRequired() *FabricConnectionConstraintBasicCredentialsPasswordWoRequired {
+	return &FabricConnectionConstraintBasicCredentialsPasswordWoRequired{}
+}
+
+func (r *FabricConnectionConstraintBasicCredentialsPasswordWoRequired) Name() string {
+	return "fabric_connection_constraint_basic_credentials_password_wo_required"
+}
+func (r *FabricConnectionConstraintBasicCredentialsPasswordWoRequired) Enabled() bool { return true }
+func (r *FabricConnectionConstraintBasicCredentialsPasswordWoRequired) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricConnectionConstraintBasicCredentialsPasswordWoRequired) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/connections.json"
+}
+
+func (r *FabricConnectionConstraintBasicCredentialsPasswordWoRequired) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedSchema("credential_details.basic_credentials.password_wo", "credential_details.basic_credentials.password_wo_version"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"credential_details.basic_credentials.password_wo"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_connection" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkRequiredWith(paths, "credential_details.basic_credentials.password_wo_version")
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricConnectionConstraintBasicCredentialsPasswordWoRequired(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "credential_details.basic_credentials.password_wo_version set with all required attributes",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    basic_credentials = {
+      password_wo = "example"
+      password_wo_version = 1
+    }
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "credential_details.basic_credentials.password_wo_version not set",
+			content: `
+resource "fabric_connection" "example" {
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "credential_details.basic_credentials.password_wo_version set alone",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    basic_credentials = {
+      password_wo_version = 1
+    }
+  }
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricConnectionConstraintBasicCredentialsPasswordWoRequired(),
+					Message: "credential_details.basic_credentials.password_wo is required when credential_details.basic_credentials.password_wo_version is set",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 29},
+						End:      hcl.Pos{Line: 5, Column: 30},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricConnectionConstraintBasicCredentialsPasswordWoRequired()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricConnectionConstraintCredentialTypeBasic struct{ tflint.DefaultRule }
+
+func NewFabricConnectionConstraintCredentialTypeBasic() *FabricConnectionConstraintCredentialTypeBasic {
+	return &FabricConnectionConstraintCredentialTypeBasic{}
+}
+
+func (r *FabricConnectionConstraintCredentialTypeBasic) Name() string {
+	return "fabric_connection_constraint_credential_type_basic"
+}
+func (r *FabricConnectionConstraintCredentialTypeBasic) Enabled() bool { return true }
+func (r *FabricConnectionConstraintCredentialTypeBasic) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricConnectionConstraintCredentialTypeBasic) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/connections.json"
+}
+
+func (r *FabricConnectionConstraintCredentialTypeBasic) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedSchema("credential_details.basic_credentials", "credential_details.credential_type"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"credential_details.basic_credentials"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_connection" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkRequiredIf(paths, "credential_details.credential_type", "Basic")
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricConnectionConstraintCredentialTypeBasic(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "credential_details.credential_type is Basic with all required attributes",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    basic_credentials = {}
+    credential_type = "Basic"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "credential_details.credential_type is another value",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "not-Basic"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "credential_details.credential_type is Basic without required attributes",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "Basic"
+  }
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricConnectionConstraintCredentialTypeBasic(),
+					Message: "credential_details.basic_credentials is required when credential_details.credential_type is \"Basic\"",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 23},
+						End:      hcl.Pos{Line: 4, Column: 30},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricConnectionConstraintCredentialTypeBasic()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
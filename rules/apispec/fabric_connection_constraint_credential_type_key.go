@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricConnectionConstraintCredentialTypeKey struct{ tflint.DefaultRule }
+
+func NewFabricConnectionConstraintCredentialTypeKey() *FabricConnectionConstraintCredentialTypeKey {
+	return &FabricConnectionConstraintCredentialTypeKey{}
+}
+
+func (r *FabricConnectionConstraintCredentialTypeKey) Name() string {
+	return "fabric_connection_constraint_credential_type_key"
+}
+func (r *FabricConnectionConstraintCredentialTypeKey) Enabled() bool             { return true }
+func (r *FabricConnectionConstraintCredentialTypeKey) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricConnectionConstraintCredentialTypeKey) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/connections.json"
+}
+
+func (r *FabricConnectionConstraintCredentialTypeKey) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedSchema("credential_details.key_credentials", "credential_details.credential_type"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"credential_details.key_credentials"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_connection" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkRequiredIf(paths, "credential_details.credential_type", "Key")
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricConnectionConstraintCredentialTypeKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "credential_details.credential_type is Key with all required attributes",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "Key"
+    key_credentials = {}
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "credential_details.credential_type is another value",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "not-Key"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "credential_details.credential_type is Key without required attributes",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "Key"
+  }
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricConnectionConstraintCredentialTypeKey(),
+					Message: "credential_details.key_credentials is required when credential_details.credential_type is \"Key\"",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 23},
+						End:      hcl.Pos{Line: 4, Column: 28},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricConnectionConstraintCredentialTypeKey()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
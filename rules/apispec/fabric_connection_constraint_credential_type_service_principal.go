@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricConnectionConstraintCredentialTypeServicePrincipal struct{ tflint.DefaultRule }
+
+func NewFabricConnectionConstraintCredentialTypeServicePrincipal() *FabricConnectionConstraintCredentialTypeServicePrincipal {
+	return &FabricConnectionConstraintCredentialTypeServicePrincipal{}
+}
+
+func (r *FabricConnectionConstraintCredentialTypeServicePrincipal) Name() string {
+	return "fabric_connection_constraint_credential_type_service_principal"
+}
+func (r *FabricConnectionConstraintCredentialTypeServicePrincipal) Enabled() bool { return true }
+func (r *FabricConnectionConstraintCredentialTypeServicePrincipal) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricConnectionConstraintCredentialTypeServicePrincipal) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/connections.json"
+}
+
+func (r *FabricConnectionConstraintCredentialTypeServicePrincipal) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedSchema("credential_details.service_principal_credentials", "credential_details.credential_type"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"credential_details.service_principal_credentials"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_connection" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkRequiredIf(paths, "credential_details.credential_type", "ServicePrincipal")
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
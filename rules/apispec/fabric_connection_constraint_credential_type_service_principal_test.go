@@ -0,0 +1,74 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricConnectionConstraintCredentialTypeServicePrincipal(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "credential_details.credential_type is ServicePrincipal with all required attributes",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "ServicePrincipal"
+    service_principal_credentials = {}
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "credential_details.credential_type is another value",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "not-ServicePrincipal"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "credential_details.credential_type is ServicePrincipal without required attributes",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "ServicePrincipal"
+  }
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricConnectionConstraintCredentialTypeServicePrincipal(),
+					Message: "credential_details.service_principal_credentials is required when credential_details.credential_type is \"ServicePrincipal\"",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 23},
+						End:      hcl.Pos{Line: 4, Column: 41},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricConnectionConstraintCredentialTypeServicePrincipal()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricConnectionConstraintCredentialTypeSharedAccessSignature struct{ tflint.DefaultRule }
+
+func NewFabricConnectionConstraintCredentialTypeSharedAccessSignature() *FabricConnectionConstraintCredentialTypeSharedAccessSignature {
+	return &FabricConnectionConstraintCredentialTypeSharedAccessSignature{}
+}
+
+func (r *FabricConnectionConstraintCredentialTypeSharedAccessSignature) Name() string {
+	return "fabric_connection_constraint_credential_type_shared_access_signature"
+}
+func (r *FabricConnectionConstraintCredentialTypeSharedAccessSignature) Enabled() bool { return true }
+func (r *FabricConnectionConstraintCredentialTypeSharedAccessSignature) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricConnectionConstraintCredentialTypeSharedAccessSignature) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/connections.json"
+}
+
+func (r *FabricConnectionConstraintCredentialTypeSharedAccessSignature) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedSchema("credential_details.shared_access_signature_credentials", "credential_details.credential_type"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"credential_details.shared_access_signature_credentials"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_connection" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkRequiredIf(paths, "credential_details.credential_type", "SharedAccessSignature")
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricConnectionConstraintCredentialTypeSharedAccessSignature(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "credential_details.credential_type is SharedAccessSignature with all required attributes",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "SharedAccessSignature"
+    shared_access_signature_credentials = {}
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "credential_details.credential_type is another value",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "not-SharedAccessSignature"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "credential_details.credential_type is SharedAccessSignature without required attributes",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "SharedAccessSignature"
+  }
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricConnectionConstraintCredentialTypeSharedAccessSignature(),
+					Message: "credential_details.shared_access_signature_credentials is required when credential_details.credential_type is \"SharedAccessSignature\"",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 23},
+						End:      hcl.Pos{Line: 4, Column: 46},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricConnectionConstraintCredentialTypeSharedAccessSignature()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricConnectionConstraintGatewayIDForbidden struct{ tflint.DefaultRule }
+
+func NewFabricConnectionConstraintGatewayIDForbidden() *FabricConnectionConstraintGatewayIDForbidden {
+	return &FabricConnectionConstraintGatewayIDForbidden{}
+}
+
+func (r *FabricConnectionConstraintGatewayIDForbidden) Name() string {
+	return "fabric_connection_constraint_gateway_id_forbidden"
+}
+func (r *FabricConnectionConstraintGatewayIDForbidden) Enabled() bool { return true }
+func (r *FabricConnectionConstraintGatewayIDForbidden) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricConnectionConstraintGatewayIDForbidden) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/connections.json"
+}
+
+func (r *FabricConnectionConstraintGatewayIDForbidden) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedSchema("gateway_id", "connectivity_type"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"gateway_id"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_connection" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkForbiddenIf(paths, "connectivity_type", "ShareableCloud")
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricConnectionConstraintGatewayIDForbidden(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "connectivity_type is ShareableCloud without forbidden attributes",
+			content: `
+resource "fabric_connection" "example" {
+  connectivity_type = "ShareableCloud"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "connectivity_type is another value",
+			content: `
+resource "fabric_connection" "example" {
+  connectivity_type = "not-ShareableCloud"
+  gateway_id = "example"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "connectivity_type is ShareableCloud with gateway_id",
+			content: `
+resource "fabric_connection" "example" {
+  connectivity_type = "ShareableCloud"
+  gateway_id = "example"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricConnectionConstraintGatewayIDForbidden(),
+					Message: "gateway_id cannot be set when connectivity_type is \"ShareableCloud\"",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 16},
+						End:      hcl.Pos{Line: 4, Column: 25},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricConnectionConstraintGatewayIDForbidden()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
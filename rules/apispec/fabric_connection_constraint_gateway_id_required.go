@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricConnectionConstraintGatewayIDRequired struct{ tflint.DefaultRule }
+
+func NewFabricConnectionConstraintGatewayIDRequired() *FabricConnectionConstraintGatewayIDRequired {
+	return &FabricConnectionConstraintGatewayIDRequired{}
+}
+
+func (r *FabricConnectionConstraintGatewayIDRequired) Name() string {
+	return "fabric_connection_constraint_gateway_id_required"
+}
+func (r *FabricConnectionConstraintGatewayIDRequired) Enabled() bool             { return true }
+func (r *FabricConnectionConstraintGatewayIDRequired) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricConnectionConstraintGatewayIDRequired) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/connections.json"
+}
+
+func (r *FabricConnectionConstraintGatewayIDRequired) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedSchema("gateway_id", "connectivity_type"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"gateway_id"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_connection" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkRequiredIf(paths, "connectivity_type", "VirtualNetworkGateway")
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricConnectionConstraintGatewayIDRequired(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "connectivity_type is VirtualNetworkGateway with all required attributes",
+			content: `
+resource "fabric_connection" "example" {
+  connectivity_type = "VirtualNetworkGateway"
+  gateway_id = "example"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "connectivity_type is another value",
+			content: `
+resource "fabric_connection" "example" {
+  connectivity_type = "not-VirtualNetworkGateway"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "connectivity_type is VirtualNetworkGateway without required attributes",
+			content: `
+resource "fabric_connection" "example" {
+  connectivity_type = "VirtualNetworkGateway"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricConnectionConstraintGatewayIDRequired(),
+					Message: "gateway_id is required when connectivity_type is \"VirtualNetworkGateway\"",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 23},
+						End:      hcl.Pos{Line: 3, Column: 46},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricConnectionConstraintGatewayIDRequired()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package apispec
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricConnectionCredentialDetailsInvalidConnectionEncryption struct{ tflint.DefaultRule }
+
+func NewFabricConnectionCredentialDetailsInvalidConnectionEncryption() *FabricConnectionCredentialDetailsInvalidConnectionEncryption {
+	return &FabricConnectionCredentialDetailsInvalidConnectionEncryption{}
+}
+
+func (r *FabricConnectionCredentialDetailsInvalidConnectionEncryption) Name() string {
+	return "fabric_connection_credential_details_invalid_connection_encryption"
+}
+func (r *FabricConnectionCredentialDetailsInvalidConnectionEncryption) Enabled() bool { return true }
+func (r *FabricConnectionCredentialDetailsInvalidConnectionEncryption) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricConnectionCredentialDetailsInvalidConnectionEncryption) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/connections.json"
+}
+
+func (r *FabricConnectionCredentialDetailsInvalidConnectionEncryption) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedSchema("credential_details.connection_encryption"), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_connection" {
+			continue
+		}
+		// Check every instance, e.g. one per nested block
+		for _, attr := range nestedAttributes(block.Body, []string{"credential_details", "connection_encryption"}) {
+			var v string
+			if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
+				continue
+			}
+			valid := false
+			for _, e := range []string{"Any", "Encrypted", "NotEncrypted"} {
+				if v == e {
+					valid = true
+					break
+				}
+			}
+			if !valid {
+				if err := runner.EmitIssue(r,
+					fmt.Sprintf("%q is an invalid value as %s, must be one of: %s", v, "credential_details.connection_encryption", "Any, Encrypted, NotEncrypted"),
+					attr.Expr.Range()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricConnectionCredentialDetailsInvalidConnectionEncryption(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "enum value Any",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    connection_encryption = "Any"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value Encrypted",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    connection_encryption = "Encrypted"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value NotEncrypted",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    connection_encryption = "NotEncrypted"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not in enum",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    connection_encryption = "invalid"
+  }
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricConnectionCredentialDetailsInvalidConnectionEncryption(),
+					Message: "\"invalid\" is an invalid value as credential_details.connection_encryption, must be one of: Any, Encrypted, NotEncrypted",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 29},
+						End:      hcl.Pos{Line: 4, Column: 38},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricConnectionCredentialDetailsInvalidConnectionEncryption()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package apispec
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricConnectionCredentialDetailsInvalidCredentialType struct{ tflint.DefaultRule }
+
+func NewFabricConnectionCredentialDetailsInvalidCredentialType() *FabricConnectionCredentialDetailsInvalidCredentialType {
+	return &FabricConnectionCredentialDetailsInvalidCredentialType{}
+}
+
+func (r *FabricConnectionCredentialDetailsInvalidCredentialType) Name() string {
+	return "fabric_connection_credential_details_invalid_credential_type"
+}
+func (r *FabricConnectionCredentialDetailsInvalidCredentialType) Enabled() bool { return true }
+func (r *FabricConnectionCredentialDetailsInvalidCredentialType) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricConnectionCredentialDetailsInvalidCredentialType) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/connections.json"
+}
+
+func (r *FabricConnectionCredentialDetailsInvalidCredentialType) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedSchema("credential_details.credential_type"), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_connection" {
+			continue
+		}
+		// Check every instance, e.g. one per nested block
+		for _, attr := range nestedAttributes(block.Body, []string{"credential_details", "credential_type"}) {
+			var v string
+			if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
+				continue
+			}
+			valid := false
+			for _, e := range []string{"Anonymous", "Basic", "Key", "OAuth2", "ServicePrincipal", "SharedAccessSignature", "Windows", "WindowsWithoutImpersonation", "WorkspaceIdentity"} {
+				if v == e {
+					valid = true
+					break
+				}
+			}
+			if !valid {
+				if err := runner.EmitIssue(r,
+					fmt.Sprintf("%q is an invalid value as %s, must be one of: %s", v, "credential_details.credential_type", "Anonymous, Basic, Key, OAuth2, ServicePrincipal, SharedAccessSignature, Windows, WindowsWithoutImpersonation, WorkspaceIdentity"),
+					attr.Expr.Range()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricConnectionCredentialDetailsInvalidCredentialType(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "enum value Anonymous",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "Anonymous"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value Basic",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "Basic"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value Key",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "Key"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value OAuth2",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "OAuth2"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value ServicePrincipal",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "ServicePrincipal"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value SharedAccessSignature",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "SharedAccessSignature"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value Windows",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "Windows"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value WindowsWithoutImpersonation",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "WindowsWithoutImpersonation"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value WorkspaceIdentity",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "WorkspaceIdentity"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not in enum",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    credential_type = "invalid"
+  }
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricConnectionCredentialDetailsInvalidCredentialType(),
+					Message: "\"invalid\" is an invalid value as credential_details.credential_type, must be one of: Anonymous, Basic, Key, OAuth2, ServicePrincipal, SharedAccessSignature, Windows, WindowsWithoutImpersonation, WorkspaceIdentity",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 23},
+						End:      hcl.Pos{Line: 4, Column: 32},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricConnectionCredentialDetailsInvalidCredentialType()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package apispec
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricConnectionCredentialDetailsInvalidSingleSignOnType struct{ tflint.DefaultRule }
+
+func NewFabricConnectionCredentialDetailsInvalidSingleSignOnType() *FabricConnectionCredentialDetailsInvalidSingleSignOnType {
+	return &FabricConnectionCredentialDetailsInvalidSingleSignOnType{}
+}
+
+func (r *FabricConnectionCredentialDetailsInvalidSingleSignOnType) Name() string {
+	return "fabric_connection_credential_details_invalid_single_sign_on_type"
+}
+func (r *FabricConnectionCredentialDetailsInvalidSingleSignOnType) Enabled() bool { return true }
+func (r *FabricConnectionCredentialDetailsInvalidSingleSignOnType) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricConnectionCredentialDetailsInvalidSingleSignOnType) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/connections.json"
+}
+
+func (r *FabricConnectionCredentialDetailsInvalidSingleSignOnType) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedSchema("credential_details.single_sign_on_type"), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_connection" {
+			continue
+		}
+		// Check every instance, e.g. one per nested block
+		for _, attr := range nestedAttributes(block.Body, []string{"credential_details", "single_sign_on_type"}) {
+			var v string
+			if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
+				continue
+			}
+			valid := false
+			for _, e := range []string{"None", "Kerberos", "MicrosoftEntraID", "SecurityAssertionMarkupLanguage", "KerberosDirectQueryAndRefresh"} {
+				if v == e {
+					valid = true
+					break
+				}
+			}
+			if !valid {
+				if err := runner.EmitIssue(r,
+					fmt.Sprintf("%q is an invalid value as %s, must be one of: %s", v, "credential_details.single_sign_on_type", "None, Kerberos, MicrosoftEntraID, SecurityAssertionMarkupLanguage, KerberosDirectQueryAndRefresh"),
+					attr.Expr.Range()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
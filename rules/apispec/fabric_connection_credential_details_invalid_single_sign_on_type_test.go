@@ -0,0 +1,106 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricConnectionCredentialDetailsInvalidSingleSignOnType(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "enum value None",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    single_sign_on_type = "None"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value Kerberos",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    single_sign_on_type = "Kerberos"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value MicrosoftEntraID",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    single_sign_on_type = "MicrosoftEntraID"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value SecurityAssertionMarkupLanguage",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    single_sign_on_type = "SecurityAssertionMarkupLanguage"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value KerberosDirectQueryAndRefresh",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    single_sign_on_type = "KerberosDirectQueryAndRefresh"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not in enum",
+			content: `
+resource "fabric_connection" "example" {
+  credential_details = {
+    single_sign_on_type = "invalid"
+  }
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricConnectionCredentialDetailsInvalidSingleSignOnType(),
+					Message: "\"invalid\" is an invalid value as credential_details.single_sign_on_type, must be one of: None, Kerberos, MicrosoftEntraID, SecurityAssertionMarkupLanguage, KerberosDirectQueryAndRefresh",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 27},
+						End:      hcl.Pos{Line: 4, Column: 36},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricConnectionCredentialDetailsInvalidSingleSignOnType()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
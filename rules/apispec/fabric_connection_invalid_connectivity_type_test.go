@@ -0,0 +1,67 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricConnectionInvalidConnectivityType(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "enum value ShareableCloud",
+			content: `
+resource "fabric_connection" "example" {
+  connectivity_type = "ShareableCloud"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value VirtualNetworkGateway",
+			content: `
+resource "fabric_connection" "example" {
+  connectivity_type = "VirtualNetworkGateway"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not in enum",
+			content: `
+resource "fabric_connection" "example" {
+  connectivity_type = "invalid"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricConnectionInvalidConnectivityType(),
+					Message: "\"invalid\" is an invalid value as connectivity_type, must be one of: ShareableCloud, VirtualNetworkGateway",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 23},
+						End:      hcl.Pos{Line: 3, Column: 32},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricConnectionInvalidConnectivityType()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
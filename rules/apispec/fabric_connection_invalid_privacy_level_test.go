@@ -0,0 +1,85 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricConnectionInvalidPrivacyLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "enum value None",
+			content: `
+resource "fabric_connection" "example" {
+  privacy_level = "None"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value Private",
+			content: `
+resource "fabric_connection" "example" {
+  privacy_level = "Private"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value Organizational",
+			content: `
+resource "fabric_connection" "example" {
+  privacy_level = "Organizational"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value Public",
+			content: `
+resource "fabric_connection" "example" {
+  privacy_level = "Public"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not in enum",
+			content: `
+resource "fabric_connection" "example" {
+  privacy_level = "invalid"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricConnectionInvalidPrivacyLevel(),
+					Message: "\"invalid\" is an invalid value as privacy_level, must be one of: None, Private, Organizational, Public",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 19},
+						End:      hcl.Pos{Line: 3, Column: 28},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricConnectionInvalidPrivacyLevel()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricCopyJobDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricCopyJobDataSourceConstraintLookup() *FabricCopyJobDataSourceConstraintLookup {
+	return &FabricCopyJobDataSourceConstraintLookup{}
+}
+
+func (r *FabricCopyJobDataSourceConstraintLookup) Name() string {
+	return "fabric_copy_job_data_source_constraint_lookup"
+}
+func (r *FabricCopyJobDataSourceConstraintLookup) Enabled() bool             { return true }
+func (r *FabricCopyJobDataSourceConstraintLookup) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricCopyJobDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/copyJob/definitions.json"
+}
+
+func (r *FabricCopyJobDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_copy_job" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package apispec
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricCopyJobDataSourceInvalidDisplayName struct{ tflint.DefaultRule }
+
+func NewFabricCopyJobDataSourceInvalidDisplayName() *FabricCopyJobDataSourceInvalidDisplayName {
+	return &FabricCopyJobDataSourceInvalidDisplayName{}
+}
+
+func (r *FabricCopyJobDataSourceInvalidDisplayName) Name() string {
+	return "fabric_copy_job_data_source_invalid_display_name"
+}
+func (r *FabricCopyJobDataSourceInvalidDisplayName) Enabled() bool             { return true }
+func (r *FabricCopyJobDataSourceInvalidDisplayName) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricCopyJobDataSourceInvalidDisplayName) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/copyJob/definitions.json"
+}
+
+func (r *FabricCopyJobDataSourceInvalidDisplayName) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "data",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "display_name"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_copy_job" {
+			continue
+		}
+		attr, ok := block.Body.Attributes["display_name"]
+		if !ok {
+			continue
+		}
+
+		var v string
+		if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
+			continue
+		}
+		if len(v) > 256 {
+			if err := runner.EmitIssue(r,
+				fmt.Sprintf("%s exceeds max length %d", "display_name", 256),
+				attr.Expr.Range()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricDataPipelineDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricDataPipelineDataSourceConstraintLookup() *FabricDataPipelineDataSourceConstraintLookup {
+	return &FabricDataPipelineDataSourceConstraintLookup{}
+}
+
+func (r *FabricDataPipelineDataSourceConstraintLookup) Name() string {
+	return "fabric_data_pipeline_data_source_constraint_lookup"
+}
+func (r *FabricDataPipelineDataSourceConstraintLookup) Enabled() bool { return true }
+func (r *FabricDataPipelineDataSourceConstraintLookup) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricDataPipelineDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/dataPipeline/definitions.json"
+}
+
+func (r *FabricDataPipelineDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_data_pipeline" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricDataflowDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricDataflowDataSourceConstraintLookup() *FabricDataflowDataSourceConstraintLookup {
+	return &FabricDataflowDataSourceConstraintLookup{}
+}
+
+func (r *FabricDataflowDataSourceConstraintLookup) Name() string {
+	return "fabric_dataflow_data_source_constraint_lookup"
+}
+func (r *FabricDataflowDataSourceConstraintLookup) Enabled() bool             { return true }
+func (r *FabricDataflowDataSourceConstraintLookup) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricDataflowDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/dataflow/definitions.json"
+}
+
+func (r *FabricDataflowDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_dataflow" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
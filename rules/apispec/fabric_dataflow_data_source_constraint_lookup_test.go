@@ -0,0 +1,77 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricDataflowDataSourceConstraintLookup(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "only id set",
+			content: `
+data "fabric_dataflow" "example" {
+  id = "example"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "none set",
+			content: `
+data "fabric_dataflow" "example" {
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricDataflowDataSourceConstraintLookup(),
+					Message: "Exactly one of id, display_name must be set",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			name: "id and display_name set",
+			content: `
+data "fabric_dataflow" "example" {
+  display_name = "example"
+  id = "example"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricDataflowDataSourceConstraintLookup(),
+					Message: "Exactly one of id, display_name must be set",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 27},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricDataflowDataSourceConstraintLookup()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
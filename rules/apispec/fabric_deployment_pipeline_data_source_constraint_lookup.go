@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricDeploymentPipelineDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricDeploymentPipelineDataSourceConstraintLookup() *FabricDeploymentPipelineDataSourceConstraintLookup {
+	return &FabricDeploymentPipelineDataSourceConstraintLookup{}
+}
+
+func (r *FabricDeploymentPipelineDataSourceConstraintLookup) Name() string {
+	return "fabric_deployment_pipeline_data_source_constraint_lookup"
+}
+func (r *FabricDeploymentPipelineDataSourceConstraintLookup) Enabled() bool { return true }
+func (r *FabricDeploymentPipelineDataSourceConstraintLookup) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricDeploymentPipelineDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/deploymentPipelines.json"
+}
+
+func (r *FabricDeploymentPipelineDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_deployment_pipeline" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package apispec
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricDeploymentPipelineDataSourceInvalidDisplayName struct{ tflint.DefaultRule }
+
+func NewFabricDeploymentPipelineDataSourceInvalidDisplayName() *FabricDeploymentPipelineDataSourceInvalidDisplayName {
+	return &FabricDeploymentPipelineDataSourceInvalidDisplayName{}
+}
+
+func (r *FabricDeploymentPipelineDataSourceInvalidDisplayName) Name() string {
+	return "fabric_deployment_pipeline_data_source_invalid_display_name"
+}
+func (r *FabricDeploymentPipelineDataSourceInvalidDisplayName) Enabled() bool { return true }
+func (r *FabricDeploymentPipelineDataSourceInvalidDisplayName) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricDeploymentPipelineDataSourceInvalidDisplayName) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/deploymentPipelines.json"
+}
+
+func (r *FabricDeploymentPipelineDataSourceInvalidDisplayName) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "data",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "display_name"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_deployment_pipeline" {
+			continue
+		}
+		attr, ok := block.Body.Attributes["display_name"]
+		if !ok {
+			continue
+		}
+
+		var v string
+		if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
+			continue
+		}
+		if len(v) > 256 {
+			if err := runner.EmitIssue(r,
+				fmt.Sprintf("%s exceeds max length %d", "display_name", 256),
+				attr.Expr.Range()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package apispec
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricDeploymentPipelineRoleAssignmentInvalidRole struct{ tflint.DefaultRule }
+
+func NewFabricDeploymentPipelineRoleAssignmentInvalidRole() *FabricDeploymentPipelineRoleAssignmentInvalidRole {
+	return &FabricDeploymentPipelineRoleAssignmentInvalidRole{}
+}
+
+func (r *FabricDeploymentPipelineRoleAssignmentInvalidRole) Name() string {
+	return "fabric_deployment_pipeline_role_assignment_invalid_role"
+}
+func (r *FabricDeploymentPipelineRoleAssignmentInvalidRole) Enabled() bool { return true }
+func (r *FabricDeploymentPipelineRoleAssignmentInvalidRole) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricDeploymentPipelineRoleAssignmentInvalidRole) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/deploymentPipelines.json"
+}
+
+func (r *FabricDeploymentPipelineRoleAssignmentInvalidRole) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "role"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_deployment_pipeline_role_assignment" {
+			continue
+		}
+		attr, ok := block.Body.Attributes["role"]
+		if !ok {
+			continue
+		}
+
+		var v string
+		if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
+			continue
+		}
+		valid := false
+		for _, e := range []string{"Admin"} {
+			if v == e {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			if err := runner.EmitIssue(r,
+				fmt.Sprintf("%q is an invalid value as %s, must be one of: %s", v, "role", "Admin"),
+				attr.Expr.Range()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricDeploymentPipelineRoleAssignmentInvalidRole(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "enum value Admin",
+			content: `
+resource "fabric_deployment_pipeline_role_assignment" "example" {
+  role = "Admin"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not in enum",
+			content: `
+resource "fabric_deployment_pipeline_role_assignment" "example" {
+  role = "invalid"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricDeploymentPipelineRoleAssignmentInvalidRole(),
+					Message: "\"invalid\" is an invalid value as role, must be one of: Admin",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 3, Column: 19},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricDeploymentPipelineRoleAssignmentInvalidRole()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
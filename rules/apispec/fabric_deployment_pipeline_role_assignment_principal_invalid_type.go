@@ -0,0 +1,60 @@
+package apispec
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricDeploymentPipelineRoleAssignmentPrincipalInvalidType struct{ tflint.DefaultRule }
+
+func NewFabricDeploymentPipelineRoleAssignmentPrincipalInvalidType() *FabricDeploymentPipelineRoleAssignmentPrincipalInvalidType {
+	return &FabricDeploymentPipelineRoleAssignmentPrincipalInvalidType{}
+}
+
+func (r *FabricDeploymentPipelineRoleAssignmentPrincipalInvalidType) Name() string {
+	return "fabric_deployment_pipeline_role_assignment_principal_invalid_type"
+}
+func (r *FabricDeploymentPipelineRoleAssignmentPrincipalInvalidType) Enabled() bool { return true }
+func (r *FabricDeploymentPipelineRoleAssignmentPrincipalInvalidType) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricDeploymentPipelineRoleAssignmentPrincipalInvalidType) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/deploymentPipelines.json"
+}
+
+func (r *FabricDeploymentPipelineRoleAssignmentPrincipalInvalidType) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedSchema("principal.type"), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_deployment_pipeline_role_assignment" {
+			continue
+		}
+		// Check every instance, e.g. one per nested block
+		for _, attr := range nestedAttributes(block.Body, []string{"principal", "type"}) {
+			var v string
+			if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
+				continue
+			}
+			valid := false
+			for _, e := range []string{"Group", "ServicePrincipal", "ServicePrincipalProfile", "User"} {
+				if v == e {
+					valid = true
+					break
+				}
+			}
+			if !valid {
+				if err := runner.EmitIssue(r,
+					fmt.Sprintf("%q is an invalid value as %s, must be one of: %s", v, "principal.type", "Group, ServicePrincipal, ServicePrincipalProfile, User"),
+					attr.Expr.Range()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
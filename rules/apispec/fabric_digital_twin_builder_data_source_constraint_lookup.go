@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricDigitalTwinBuilderDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricDigitalTwinBuilderDataSourceConstraintLookup() *FabricDigitalTwinBuilderDataSourceConstraintLookup {
+	return &FabricDigitalTwinBuilderDataSourceConstraintLookup{}
+}
+
+func (r *FabricDigitalTwinBuilderDataSourceConstraintLookup) Name() string {
+	return "fabric_digital_twin_builder_data_source_constraint_lookup"
+}
+func (r *FabricDigitalTwinBuilderDataSourceConstraintLookup) Enabled() bool { return true }
+func (r *FabricDigitalTwinBuilderDataSourceConstraintLookup) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricDigitalTwinBuilderDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/digitalTwinBuilder/definitions.json"
+}
+
+func (r *FabricDigitalTwinBuilderDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_digital_twin_builder" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
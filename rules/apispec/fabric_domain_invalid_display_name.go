@@ -58,7 +58,6 @@ func (r *FabricDomainInvalidDisplayName) Check(runner tflint.Runner) error {
 				return err
 			}
 		}
-		// TODO: add pattern/enum checks if needed
 	}
 
 	return nil
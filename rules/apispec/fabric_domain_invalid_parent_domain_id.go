@@ -51,7 +51,6 @@ func (r *FabricDomainInvalidParentDomainID) Check(runner tflint.Runner) error {
 		if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
 			continue
 		}
-		// TODO: add pattern/enum checks if needed
 	}
 
 	return nil
@@ -0,0 +1,73 @@
+package apispec
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricDomainRoleAssignmentsInvalidRole struct{ tflint.DefaultRule }
+
+func NewFabricDomainRoleAssignmentsInvalidRole() *FabricDomainRoleAssignmentsInvalidRole {
+	return &FabricDomainRoleAssignmentsInvalidRole{}
+}
+
+func (r *FabricDomainRoleAssignmentsInvalidRole) Name() string {
+	return "fabric_domain_role_assignments_invalid_role"
+}
+func (r *FabricDomainRoleAssignmentsInvalidRole) Enabled() bool             { return true }
+func (r *FabricDomainRoleAssignmentsInvalidRole) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricDomainRoleAssignmentsInvalidRole) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/admin/definitions/domains.json"
+}
+
+func (r *FabricDomainRoleAssignmentsInvalidRole) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "role"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_domain_role_assignments" {
+			continue
+		}
+		attr, ok := block.Body.Attributes["role"]
+		if !ok {
+			continue
+		}
+
+		var v string
+		if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
+			continue
+		}
+		valid := false
+		for _, e := range []string{"Admins", "Contributors"} {
+			if v == e {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			if err := runner.EmitIssue(r,
+				fmt.Sprintf("%q is an invalid value as %s, must be one of: %s", v, "role", "Admins, Contributors"),
+				attr.Expr.Range()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricDomainRoleAssignmentsInvalidRole(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "enum value Admins",
+			content: `
+resource "fabric_domain_role_assignments" "example" {
+  role = "Admins"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value Contributors",
+			content: `
+resource "fabric_domain_role_assignments" "example" {
+  role = "Contributors"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not in enum",
+			content: `
+resource "fabric_domain_role_assignments" "example" {
+  role = "invalid"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricDomainRoleAssignmentsInvalidRole(),
+					Message: "\"invalid\" is an invalid value as role, must be one of: Admins, Contributors",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 3, Column: 19},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricDomainRoleAssignmentsInvalidRole()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package apispec
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricDomainRoleAssignmentsPrincipalsInvalidType struct{ tflint.DefaultRule }
+
+func NewFabricDomainRoleAssignmentsPrincipalsInvalidType() *FabricDomainRoleAssignmentsPrincipalsInvalidType {
+	return &FabricDomainRoleAssignmentsPrincipalsInvalidType{}
+}
+
+func (r *FabricDomainRoleAssignmentsPrincipalsInvalidType) Name() string {
+	return "fabric_domain_role_assignments_principals_invalid_type"
+}
+func (r *FabricDomainRoleAssignmentsPrincipalsInvalidType) Enabled() bool { return false }
+func (r *FabricDomainRoleAssignmentsPrincipalsInvalidType) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricDomainRoleAssignmentsPrincipalsInvalidType) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/admin/definitions/domains.json"
+}
+
+func (r *FabricDomainRoleAssignmentsPrincipalsInvalidType) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedSchema("principals.type"), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_domain_role_assignments" {
+			continue
+		}
+		// Check every instance, e.g. one per nested block
+		for _, attr := range nestedAttributes(block.Body, []string{"principals", "type"}) {
+			var v string
+			if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
+				continue
+			}
+			valid := false
+			for _, e := range []string{"Group", "User"} {
+				if v == e {
+					valid = true
+					break
+				}
+			}
+			if !valid {
+				if err := runner.EmitIssue(r,
+					fmt.Sprintf("%q is an invalid value as %s, must be one of: %s", v, "principals.type", "Group, User"),
+					attr.Expr.Range()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricEnvironmentDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricEnvironmentDataSourceConstraintLookup() *FabricEnvironmentDataSourceConstraintLookup {
+	return &FabricEnvironmentDataSourceConstraintLookup{}
+}
+
+func (r *FabricEnvironmentDataSourceConstraintLookup) Name() string {
+	return "fabric_environment_data_source_constraint_lookup"
+}
+func (r *FabricEnvironmentDataSourceConstraintLookup) Enabled() bool             { return true }
+func (r *FabricEnvironmentDataSourceConstraintLookup) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricEnvironmentDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/environment/definitions.json"
+}
+
+func (r *FabricEnvironmentDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_environment" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
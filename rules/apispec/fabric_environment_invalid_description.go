@@ -60,7 +60,6 @@ func (r *FabricEnvironmentInvalidDescription) Check(runner tflint.Runner) error
 				return err
 			}
 		}
-		// TODO: add pattern/enum checks if needed
 	}
 
 	return nil
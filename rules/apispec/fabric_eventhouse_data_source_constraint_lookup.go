@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricEventhouseDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricEventhouseDataSourceConstraintLookup() *FabricEventhouseDataSourceConstraintLookup {
+	return &FabricEventhouseDataSourceConstraintLookup{}
+}
+
+func (r *FabricEventhouseDataSourceConstraintLookup) Name() string {
+	return "fabric_eventhouse_data_source_constraint_lookup"
+}
+func (r *FabricEventhouseDataSourceConstraintLookup) Enabled() bool             { return true }
+func (r *FabricEventhouseDataSourceConstraintLookup) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricEventhouseDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/eventhouse/definitions.json"
+}
+
+func (r *FabricEventhouseDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_eventhouse" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package apispec
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
@@ -40,6 +42,9 @@ func (r *FabricEventhouseInvalidDisplayName) Check(runner tflint.Runner) error {
 		return err
 	}
 
+	pattern := regexp.MustCompile("^[a-zA-Z0-9._-]+$")
+	allowedCharacter := regexp.MustCompile("^[a-zA-Z0-9._-]$")
+
 	for _, block := range content.Blocks {
 		if block.Labels[0] != "fabric_eventhouse" {
 			continue
@@ -60,7 +65,24 @@ func (r *FabricEventhouseInvalidDisplayName) Check(runner tflint.Runner) error {
 				return err
 			}
 		}
-		// TODO: add pattern/enum checks if needed
+		if !pattern.MatchString(v) {
+			message := fmt.Sprintf("%s %q %s", "display_name", v, "must match the pattern ^[a-zA-Z0-9._-]+$")
+			// List each offending character once, in order of appearance
+			var invalid []string
+			seen := make(map[rune]bool)
+			for _, c := range v {
+				if !seen[c] && !allowedCharacter.MatchString(string(c)) {
+					seen[c] = true
+					invalid = append(invalid, fmt.Sprintf("%q", c))
+				}
+			}
+			if len(invalid) > 0 {
+				message = fmt.Sprintf("%s (invalid characters: %s)", message, strings.Join(invalid, ", "))
+			}
+			if err := runner.EmitIssue(r, message, attr.Expr.Range()); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricEventstreamDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricEventstreamDataSourceConstraintLookup() *FabricEventstreamDataSourceConstraintLookup {
+	return &FabricEventstreamDataSourceConstraintLookup{}
+}
+
+func (r *FabricEventstreamDataSourceConstraintLookup) Name() string {
+	return "fabric_eventstream_data_source_constraint_lookup"
+}
+func (r *FabricEventstreamDataSourceConstraintLookup) Enabled() bool             { return true }
+func (r *FabricEventstreamDataSourceConstraintLookup) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricEventstreamDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/eventstream/definitions.json"
+}
+
+func (r *FabricEventstreamDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_eventstream" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricGatewayDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricGatewayDataSourceConstraintLookup() *FabricGatewayDataSourceConstraintLookup {
+	return &FabricGatewayDataSourceConstraintLookup{}
+}
+
+func (r *FabricGatewayDataSourceConstraintLookup) Name() string {
+	return "fabric_gateway_data_source_constraint_lookup"
+}
+func (r *FabricGatewayDataSourceConstraintLookup) Enabled() bool             { return true }
+func (r *FabricGatewayDataSourceConstraintLookup) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricGatewayDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/gateways.json"
+}
+
+func (r *FabricGatewayDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_gateway" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricGatewayInvalidInactivityMinutesBeforeSleep(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "enum value 30",
+			content: `
+resource "fabric_gateway" "example" {
+  inactivity_minutes_before_sleep = "30"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 60",
+			content: `
+resource "fabric_gateway" "example" {
+  inactivity_minutes_before_sleep = "60"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 90",
+			content: `
+resource "fabric_gateway" "example" {
+  inactivity_minutes_before_sleep = "90"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 120",
+			content: `
+resource "fabric_gateway" "example" {
+  inactivity_minutes_before_sleep = "120"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 150",
+			content: `
+resource "fabric_gateway" "example" {
+  inactivity_minutes_before_sleep = "150"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 240",
+			content: `
+resource "fabric_gateway" "example" {
+  inactivity_minutes_before_sleep = "240"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 360",
+			content: `
+resource "fabric_gateway" "example" {
+  inactivity_minutes_before_sleep = "360"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 480",
+			content: `
+resource "fabric_gateway" "example" {
+  inactivity_minutes_before_sleep = "480"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 720",
+			content: `
+resource "fabric_gateway" "example" {
+  inactivity_minutes_before_sleep = "720"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 1440",
+			content: `
+resource "fabric_gateway" "example" {
+  inactivity_minutes_before_sleep = "1440"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not in enum",
+			content: `
+resource "fabric_gateway" "example" {
+  inactivity_minutes_before_sleep = "45"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricGatewayInvalidInactivityMinutesBeforeSleep(),
+					Message: "inactivity_minutes_before_sleep must be one of: [30 60 90 120 150 240 360 480 720 1440]",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 37},
+						End:      hcl.Pos{Line: 3, Column: 41},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricGatewayInvalidInactivityMinutesBeforeSleep()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package apispec
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricGatewayRoleAssignmentInvalidRole struct{ tflint.DefaultRule }
+
+func NewFabricGatewayRoleAssignmentInvalidRole() *FabricGatewayRoleAssignmentInvalidRole {
+	return &FabricGatewayRoleAssignmentInvalidRole{}
+}
+
+func (r *FabricGatewayRoleAssignmentInvalidRole) Name() string {
+	return "fabric_gateway_role_assignment_invalid_role"
+}
+func (r *FabricGatewayRoleAssignmentInvalidRole) Enabled() bool             { return true }
+func (r *FabricGatewayRoleAssignmentInvalidRole) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricGatewayRoleAssignmentInvalidRole) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/gateways.json"
+}
+
+func (r *FabricGatewayRoleAssignmentInvalidRole) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "role"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_gateway_role_assignment" {
+			continue
+		}
+		attr, ok := block.Body.Attributes["role"]
+		if !ok {
+			continue
+		}
+
+		var v string
+		if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
+			continue
+		}
+		valid := false
+		for _, e := range []string{"Admin", "ConnectionCreator", "ConnectionCreatorWithResharing"} {
+			if v == e {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			if err := runner.EmitIssue(r,
+				fmt.Sprintf("%q is an invalid value as %s, must be one of: %s", v, "role", "Admin, ConnectionCreator, ConnectionCreatorWithResharing"),
+				attr.Expr.Range()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricGatewayRoleAssignmentInvalidRole(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "enum value Admin",
+			content: `
+resource "fabric_gateway_role_assignment" "example" {
+  role = "Admin"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value ConnectionCreator",
+			content: `
+resource "fabric_gateway_role_assignment" "example" {
+  role = "ConnectionCreator"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value ConnectionCreatorWithResharing",
+			content: `
+resource "fabric_gateway_role_assignment" "example" {
+  role = "ConnectionCreatorWithResharing"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not in enum",
+			content: `
+resource "fabric_gateway_role_assignment" "example" {
+  role = "invalid"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricGatewayRoleAssignmentInvalidRole(),
+					Message: "\"invalid\" is an invalid value as role, must be one of: Admin, ConnectionCreator, ConnectionCreatorWithResharing",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 3, Column: 19},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricGatewayRoleAssignmentInvalidRole()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
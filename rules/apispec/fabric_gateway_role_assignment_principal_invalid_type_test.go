@@ -0,0 +1,95 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricGatewayRoleAssignmentPrincipalInvalidType(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "enum value Group",
+			content: `
+resource "fabric_gateway_role_assignment" "example" {
+  principal = {
+    type = "Group"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value ServicePrincipal",
+			content: `
+resource "fabric_gateway_role_assignment" "example" {
+  principal = {
+    type = "ServicePrincipal"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value ServicePrincipalProfile",
+			content: `
+resource "fabric_gateway_role_assignment" "example" {
+  principal = {
+    type = "ServicePrincipalProfile"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value User",
+			content: `
+resource "fabric_gateway_role_assignment" "example" {
+  principal = {
+    type = "User"
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not in enum",
+			content: `
+resource "fabric_gateway_role_assignment" "example" {
+  principal = {
+    type = "invalid"
+  }
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricGatewayRoleAssignmentPrincipalInvalidType(),
+					Message: "\"invalid\" is an invalid value as principal.type, must be one of: Group, ServicePrincipal, ServicePrincipalProfile, User",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 12},
+						End:      hcl.Pos{Line: 4, Column: 21},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricGatewayRoleAssignmentPrincipalInvalidType()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricGraphqlAPIDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricGraphqlAPIDataSourceConstraintLookup() *FabricGraphqlAPIDataSourceConstraintLookup {
+	return &FabricGraphqlAPIDataSourceConstraintLookup{}
+}
+
+func (r *FabricGraphqlAPIDataSourceConstraintLookup) Name() string {
+	return "fabric_graphql_api_data_source_constraint_lookup"
+}
+func (r *FabricGraphqlAPIDataSourceConstraintLookup) Enabled() bool             { return true }
+func (r *FabricGraphqlAPIDataSourceConstraintLookup) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricGraphqlAPIDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/graphQLApi/definitions.json"
+}
+
+func (r *FabricGraphqlAPIDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_graphql_api" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
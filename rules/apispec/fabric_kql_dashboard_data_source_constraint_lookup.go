@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricKQLDashboardDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricKQLDashboardDataSourceConstraintLookup() *FabricKQLDashboardDataSourceConstraintLookup {
+	return &FabricKQLDashboardDataSourceConstraintLookup{}
+}
+
+func (r *FabricKQLDashboardDataSourceConstraintLookup) Name() string {
+	return "fabric_kql_dashboard_data_source_constraint_lookup"
+}
+func (r *FabricKQLDashboardDataSourceConstraintLookup) Enabled() bool { return true }
+func (r *FabricKQLDashboardDataSourceConstraintLookup) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricKQLDashboardDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/kqlDashboard/definitions.json"
+}
+
+func (r *FabricKQLDashboardDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_kql_dashboard" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
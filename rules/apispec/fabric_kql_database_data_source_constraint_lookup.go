@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricKQLDatabaseDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricKQLDatabaseDataSourceConstraintLookup() *FabricKQLDatabaseDataSourceConstraintLookup {
+	return &FabricKQLDatabaseDataSourceConstraintLookup{}
+}
+
+func (r *FabricKQLDatabaseDataSourceConstraintLookup) Name() string {
+	return "fabric_kql_database_data_source_constraint_lookup"
+}
+func (r *FabricKQLDatabaseDataSourceConstraintLookup) Enabled() bool             { return true }
+func (r *FabricKQLDatabaseDataSourceConstraintLookup) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricKQLDatabaseDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/kqlDatabase/definitions.json"
+}
+
+func (r *FabricKQLDatabaseDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_kql_database" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
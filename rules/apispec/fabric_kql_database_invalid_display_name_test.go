@@ -0,0 +1,86 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricKQLDatabaseInvalidDisplayName(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "valid value",
+			content: `
+resource "fabric_kql_database" "example" {
+  display_name = "example"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not matching pattern",
+			content: `
+resource "fabric_kql_database" "example" {
+  display_name = "example value!"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricKQLDatabaseInvalidDisplayName(),
+					Message: "display_name \"example value!\" can contain only letters, numbers, spaces, periods, hyphens and underscores (invalid characters: '!')",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 34},
+					},
+				},
+			},
+		},
+		{
+			name: "reserved word $systemdb",
+			content: `
+resource "fabric_kql_database" "example" {
+  display_name = "$systemdb"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricKQLDatabaseInvalidDisplayName(),
+					Message: "display_name \"$systemdb\" can contain only letters, numbers, spaces, periods, hyphens and underscores (invalid characters: '$')",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+				{
+					Rule:    NewFabricKQLDatabaseInvalidDisplayName(),
+					Message: "\"$systemdb\" is a reserved name and cannot be used as display_name",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricKQLDatabaseInvalidDisplayName()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
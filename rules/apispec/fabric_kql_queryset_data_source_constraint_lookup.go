@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricKQLQuerysetDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricKQLQuerysetDataSourceConstraintLookup() *FabricKQLQuerysetDataSourceConstraintLookup {
+	return &FabricKQLQuerysetDataSourceConstraintLookup{}
+}
+
+func (r *FabricKQLQuerysetDataSourceConstraintLookup) Name() string {
+	return "fabric_kql_queryset_data_source_constraint_lookup"
+}
+func (r *FabricKQLQuerysetDataSourceConstraintLookup) Enabled() bool             { return true }
+func (r *FabricKQLQuerysetDataSourceConstraintLookup) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricKQLQuerysetDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/kqlQueryset/definitions.json"
+}
+
+func (r *FabricKQLQuerysetDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_kql_queryset" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
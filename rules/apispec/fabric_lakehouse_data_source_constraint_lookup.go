@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricLakehouseDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricLakehouseDataSourceConstraintLookup() *FabricLakehouseDataSourceConstraintLookup {
+	return &FabricLakehouseDataSourceConstraintLookup{}
+}
+
+func (r *FabricLakehouseDataSourceConstraintLookup) Name() string {
+	return "fabric_lakehouse_data_source_constraint_lookup"
+}
+func (r *FabricLakehouseDataSourceConstraintLookup) Enabled() bool             { return true }
+func (r *FabricLakehouseDataSourceConstraintLookup) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricLakehouseDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/lakehouse/definitions.json"
+}
+
+func (r *FabricLakehouseDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_lakehouse" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package apispec
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricLakehouseDataSourceInvalidDisplayName struct{ tflint.DefaultRule }
+
+func NewFabricLakehouseDataSourceInvalidDisplayName() *FabricLakehouseDataSourceInvalidDisplayName {
+	return &FabricLakehouseDataSourceInvalidDisplayName{}
+}
+
+func (r *FabricLakehouseDataSourceInvalidDisplayName) Name() string {
+	return "fabric_lakehouse_data_source_invalid_display_name"
+}
+func (r *FabricLakehouseDataSourceInvalidDisplayName) Enabled() bool             { return true }
+func (r *FabricLakehouseDataSourceInvalidDisplayName) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricLakehouseDataSourceInvalidDisplayName) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/lakehouse/definitions.json"
+}
+
+func (r *FabricLakehouseDataSourceInvalidDisplayName) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "data",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "display_name"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	pattern := regexp.MustCompile("^[a-zA-Z][a-zA-Z0-9_]*$")
+	allowedCharacter := regexp.MustCompile("^[a-zA-Z0-9_]$")
+
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_lakehouse" {
+			continue
+		}
+		attr, ok := block.Body.Attributes["display_name"]
+		if !ok {
+			continue
+		}
+
+		var v string
+		if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
+			continue
+		}
+		if len(v) > 123 {
+			if err := runner.EmitIssue(r,
+				fmt.Sprintf("%s exceeds max length %d", "display_name", 123),
+				attr.Expr.Range()); err != nil {
+				return err
+			}
+		}
+		if !pattern.MatchString(v) {
+			message := fmt.Sprintf("%s %q %s", "display_name", v, "must start with a letter and contain only letters, numbers and underscores")
+			// List each offending character once, in order of appearance
+			var invalid []string
+			seen := make(map[rune]bool)
+			for _, c := range v {
+				if !seen[c] && !allowedCharacter.MatchString(string(c)) {
+					seen[c] = true
+					invalid = append(invalid, fmt.Sprintf("%q", c))
+				}
+			}
+			if len(invalid) > 0 {
+				message = fmt.Sprintf("%s (invalid characters: %s)", message, strings.Join(invalid, ", "))
+			}
+			if err := runner.EmitIssue(r, message, attr.Expr.Range()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
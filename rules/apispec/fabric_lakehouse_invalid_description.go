@@ -1,8 +1,6 @@
 package apispec
 
 import (
-	"fmt"
-
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
@@ -17,7 +15,7 @@ func (r *FabricLakehouseInvalidDescription) Name() string {
 	return "fabric_lakehouse_invalid_description"
 }
 func (r *FabricLakehouseInvalidDescription) Enabled() bool             { return true }
-func (r *FabricLakehouseInvalidDescription) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricLakehouseInvalidDescription) Severity() tflint.Severity { return tflint.WARNING }
 func (r *FabricLakehouseInvalidDescription) Link() string {
 	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/lakehouse/definitions.json"
 }
@@ -55,12 +53,11 @@ func (r *FabricLakehouseInvalidDescription) Check(runner tflint.Runner) error {
 		}
 		if len(v) > 256 {
 			if err := runner.EmitIssue(r,
-				fmt.Sprintf("%s exceeds max length %d", "description", 256),
+				issueMessage("description is {actual} characters, lakehouse descriptions are limited to {limit}", v, 256, len(v)),
 				attr.Expr.Range()); err != nil {
 				return err
 			}
 		}
-		// TODO: add pattern/enum checks if needed
 	}
 
 	return nil
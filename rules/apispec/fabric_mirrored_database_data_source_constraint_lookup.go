@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricMirroredDatabaseDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricMirroredDatabaseDataSourceConstraintLookup() *FabricMirroredDatabaseDataSourceConstraintLookup {
+	return &FabricMirroredDatabaseDataSourceConstraintLookup{}
+}
+
+func (r *FabricMirroredDatabaseDataSourceConstraintLookup) Name() string {
+	return "fabric_mirrored_database_data_source_constraint_lookup"
+}
+func (r *FabricMirroredDatabaseDataSourceConstraintLookup) Enabled() bool { return true }
+func (r *FabricMirroredDatabaseDataSourceConstraintLookup) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricMirroredDatabaseDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/mirroredDatabase/definitions.json"
+}
+
+func (r *FabricMirroredDatabaseDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_mirrored_database" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
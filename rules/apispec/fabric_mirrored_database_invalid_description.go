@@ -60,7 +60,6 @@ func (r *FabricMirroredDatabaseInvalidDescription) Check(runner tflint.Runner) e
 				return err
 			}
 		}
-		// TODO: add pattern/enum checks if needed
 	}
 
 	return nil
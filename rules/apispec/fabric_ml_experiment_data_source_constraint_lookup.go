@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricMlExperimentDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricMlExperimentDataSourceConstraintLookup() *FabricMlExperimentDataSourceConstraintLookup {
+	return &FabricMlExperimentDataSourceConstraintLookup{}
+}
+
+func (r *FabricMlExperimentDataSourceConstraintLookup) Name() string {
+	return "fabric_ml_experiment_data_source_constraint_lookup"
+}
+func (r *FabricMlExperimentDataSourceConstraintLookup) Enabled() bool { return true }
+func (r *FabricMlExperimentDataSourceConstraintLookup) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricMlExperimentDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/mlExperiment/definitions.json"
+}
+
+func (r *FabricMlExperimentDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_ml_experiment" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
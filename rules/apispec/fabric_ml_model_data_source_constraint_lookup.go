@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricMlModelDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricMlModelDataSourceConstraintLookup() *FabricMlModelDataSourceConstraintLookup {
+	return &FabricMlModelDataSourceConstraintLookup{}
+}
+
+func (r *FabricMlModelDataSourceConstraintLookup) Name() string {
+	return "fabric_ml_model_data_source_constraint_lookup"
+}
+func (r *FabricMlModelDataSourceConstraintLookup) Enabled() bool             { return true }
+func (r *FabricMlModelDataSourceConstraintLookup) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricMlModelDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/mlModel/definitions.json"
+}
+
+func (r *FabricMlModelDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_ml_model" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
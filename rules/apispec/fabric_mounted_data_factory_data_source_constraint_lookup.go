@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricMountedDataFactoryDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricMountedDataFactoryDataSourceConstraintLookup() *FabricMountedDataFactoryDataSourceConstraintLookup {
+	return &FabricMountedDataFactoryDataSourceConstraintLookup{}
+}
+
+func (r *FabricMountedDataFactoryDataSourceConstraintLookup) Name() string {
+	return "fabric_mounted_data_factory_data_source_constraint_lookup"
+}
+func (r *FabricMountedDataFactoryDataSourceConstraintLookup) Enabled() bool { return true }
+func (r *FabricMountedDataFactoryDataSourceConstraintLookup) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricMountedDataFactoryDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/mountedDataFactory/definitions.json"
+}
+
+func (r *FabricMountedDataFactoryDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_mounted_data_factory" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
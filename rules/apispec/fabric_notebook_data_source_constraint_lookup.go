@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricNotebookDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricNotebookDataSourceConstraintLookup() *FabricNotebookDataSourceConstraintLookup {
+	return &FabricNotebookDataSourceConstraintLookup{}
+}
+
+func (r *FabricNotebookDataSourceConstraintLookup) Name() string {
+	return "fabric_notebook_data_source_constraint_lookup"
+}
+func (r *FabricNotebookDataSourceConstraintLookup) Enabled() bool             { return true }
+func (r *FabricNotebookDataSourceConstraintLookup) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricNotebookDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/notebook/definitions.json"
+}
+
+func (r *FabricNotebookDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_notebook" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -60,7 +60,6 @@ func (r *FabricSemanticModelInvalidDescription) Check(runner tflint.Runner) erro
 				return err
 			}
 		}
-		// TODO: add pattern/enum checks if needed
 	}
 
 	return nil
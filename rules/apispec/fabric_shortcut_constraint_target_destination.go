@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricShortcutConstraintTargetDestination struct{ tflint.DefaultRule }
+
+func NewFabricShortcutConstraintTargetDestination() *FabricShortcutConstraintTargetDestination {
+	return &FabricShortcutConstraintTargetDestination{}
+}
+
+func (r *FabricShortcutConstraintTargetDestination) Name() string {
+	return "fabric_shortcut_constraint_target_destination"
+}
+func (r *FabricShortcutConstraintTargetDestination) Enabled() bool             { return true }
+func (r *FabricShortcutConstraintTargetDestination) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricShortcutConstraintTargetDestination) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/platform.json"
+}
+
+func (r *FabricShortcutConstraintTargetDestination) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedSchema("target.adls_gen2", "target.amazon_s3", "target.azure_blob_storage", "target.dataverse", "target.external_data_share", "target.google_cloud_storage", "target.onelake", "target.s3_compatible"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"target.adls_gen2", "target.amazon_s3", "target.azure_blob_storage", "target.dataverse", "target.external_data_share", "target.google_cloud_storage", "target.onelake", "target.s3_compatible"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_shortcut" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
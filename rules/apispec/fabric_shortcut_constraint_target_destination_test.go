@@ -0,0 +1,81 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricShortcutConstraintTargetDestination(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "only target.adls_gen2 set",
+			content: `
+resource "fabric_shortcut" "example" {
+  target = {
+    adls_gen2 = {}
+  }
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "none set",
+			content: `
+resource "fabric_shortcut" "example" {
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricShortcutConstraintTargetDestination(),
+					Message: "Exactly one of target.adls_gen2, target.amazon_s3, target.azure_blob_storage, target.dataverse, target.external_data_share, target.google_cloud_storage, target.onelake, target.s3_compatible must be set",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			name: "target.adls_gen2 and target.amazon_s3 set",
+			content: `
+resource "fabric_shortcut" "example" {
+  target = {
+    adls_gen2 = {}
+    amazon_s3 = {}
+  }
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricShortcutConstraintTargetDestination(),
+					Message: "Exactly one of target.adls_gen2, target.amazon_s3, target.azure_blob_storage, target.dataverse, target.external_data_share, target.google_cloud_storage, target.onelake, target.s3_compatible must be set",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 17},
+						End:      hcl.Pos{Line: 5, Column: 19},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricShortcutConstraintTargetDestination()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package apispec
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricShortcutTargetOnelakeInvalidPath struct{ tflint.DefaultRule }
+
+func NewFabricShortcutTargetOnelakeInvalidPath() *FabricShortcutTargetOnelakeInvalidPath {
+	return &FabricShortcutTargetOnelakeInvalidPath{}
+}
+
+func (r *FabricShortcutTargetOnelakeInvalidPath) Name() string {
+	return "fabric_shortcut_target_onelake_invalid_path"
+}
+func (r *FabricShortcutTargetOnelakeInvalidPath) Enabled() bool             { return true }
+func (r *FabricShortcutTargetOnelakeInvalidPath) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricShortcutTargetOnelakeInvalidPath) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/platform.json"
+}
+
+func (r *FabricShortcutTargetOnelakeInvalidPath) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedSchema("target.onelake.path"), nil)
+	if err != nil {
+		return err
+	}
+
+	pattern := regexp.MustCompile("^[^/]")
+
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_shortcut" {
+			continue
+		}
+		// Check every instance, e.g. one per nested block
+		for _, attr := range nestedAttributes(block.Body, []string{"target", "onelake", "path"}) {
+			var v string
+			if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
+				continue
+			}
+			if len(v) > 256 {
+				if err := runner.EmitIssue(r,
+					fmt.Sprintf("%s exceeds max length %d", "target.onelake.path", 256),
+					attr.Expr.Range()); err != nil {
+					return err
+				}
+			}
+			if !pattern.MatchString(v) {
+				message := fmt.Sprintf("%s %q %s", "target.onelake.path", v, "must be relative to the item root and not start with '/'")
+				if err := runner.EmitIssue(r, message, attr.Expr.Range()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricSparkCustomPoolDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricSparkCustomPoolDataSourceConstraintLookup() *FabricSparkCustomPoolDataSourceConstraintLookup {
+	return &FabricSparkCustomPoolDataSourceConstraintLookup{}
+}
+
+func (r *FabricSparkCustomPoolDataSourceConstraintLookup) Name() string {
+	return "fabric_spark_custom_pool_data_source_constraint_lookup"
+}
+func (r *FabricSparkCustomPoolDataSourceConstraintLookup) Enabled() bool { return true }
+func (r *FabricSparkCustomPoolDataSourceConstraintLookup) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricSparkCustomPoolDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/spark/definitions.json"
+}
+
+func (r *FabricSparkCustomPoolDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_spark_custom_pool" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
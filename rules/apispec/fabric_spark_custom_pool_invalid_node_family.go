@@ -1,6 +1,8 @@
 package apispec
 
 import (
+	"fmt"
+
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
@@ -51,7 +53,20 @@ func (r *FabricSparkCustomPoolInvalidNodeFamily) Check(runner tflint.Runner) err
 		if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
 			continue
 		}
-		// TODO: add pattern/enum checks if needed
+		valid := false
+		for _, e := range []string{"MemoryOptimized"} {
+			if v == e {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			if err := runner.EmitIssue(r,
+				fmt.Sprintf("%q is an invalid value as %s, must be one of: %s", v, "node_family", "MemoryOptimized"),
+				attr.Expr.Range()); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
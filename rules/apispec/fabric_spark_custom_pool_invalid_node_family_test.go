@@ -0,0 +1,58 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricSparkCustomPoolInvalidNodeFamily(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "enum value MemoryOptimized",
+			content: `
+resource "fabric_spark_custom_pool" "example" {
+  node_family = "MemoryOptimized"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not in enum",
+			content: `
+resource "fabric_spark_custom_pool" "example" {
+  node_family = "invalid"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricSparkCustomPoolInvalidNodeFamily(),
+					Message: "\"invalid\" is an invalid value as node_family, must be one of: MemoryOptimized",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 26},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricSparkCustomPoolInvalidNodeFamily()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricSparkCustomPoolInvalidNodeSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "enum value Small",
+			content: `
+resource "fabric_spark_custom_pool" "example" {
+  node_size = "Small"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value Medium",
+			content: `
+resource "fabric_spark_custom_pool" "example" {
+  node_size = "Medium"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value Large",
+			content: `
+resource "fabric_spark_custom_pool" "example" {
+  node_size = "Large"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value XLarge",
+			content: `
+resource "fabric_spark_custom_pool" "example" {
+  node_size = "XLarge"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value XXLarge",
+			content: `
+resource "fabric_spark_custom_pool" "example" {
+  node_size = "XXLarge"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not in enum",
+			content: `
+resource "fabric_spark_custom_pool" "example" {
+  node_size = "invalid"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricSparkCustomPoolInvalidNodeSize(),
+					Message: "\"invalid\" is an invalid value as node_size, must be one of: Small, Medium, Large, XLarge, XXLarge",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 15},
+						End:      hcl.Pos{Line: 3, Column: 24},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricSparkCustomPoolInvalidNodeSize()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
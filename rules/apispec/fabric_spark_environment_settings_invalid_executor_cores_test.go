@@ -0,0 +1,94 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricSparkEnvironmentSettingsInvalidExecutorCores(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "enum value 4",
+			content: `
+resource "fabric_spark_environment_settings" "example" {
+  executor_cores = "4"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 8",
+			content: `
+resource "fabric_spark_environment_settings" "example" {
+  executor_cores = "8"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 16",
+			content: `
+resource "fabric_spark_environment_settings" "example" {
+  executor_cores = "16"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 32",
+			content: `
+resource "fabric_spark_environment_settings" "example" {
+  executor_cores = "32"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 64",
+			content: `
+resource "fabric_spark_environment_settings" "example" {
+  executor_cores = "64"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not in enum",
+			content: `
+resource "fabric_spark_environment_settings" "example" {
+  executor_cores = "invalid"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricSparkEnvironmentSettingsInvalidExecutorCores(),
+					Message: "\"invalid\" is an invalid value as executor_cores, must be one of: 4, 8, 16, 32, 64",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 20},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricSparkEnvironmentSettingsInvalidExecutorCores()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricSparkEnvironmentSettingsInvalidExecutorMemory(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "enum value 28g",
+			content: `
+resource "fabric_spark_environment_settings" "example" {
+  executor_memory = "28g"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 56g",
+			content: `
+resource "fabric_spark_environment_settings" "example" {
+  executor_memory = "56g"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 112g",
+			content: `
+resource "fabric_spark_environment_settings" "example" {
+  executor_memory = "112g"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 224g",
+			content: `
+resource "fabric_spark_environment_settings" "example" {
+  executor_memory = "224g"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 400g",
+			content: `
+resource "fabric_spark_environment_settings" "example" {
+  executor_memory = "400g"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not in enum",
+			content: `
+resource "fabric_spark_environment_settings" "example" {
+  executor_memory = "invalid"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricSparkEnvironmentSettingsInvalidExecutorMemory(),
+					Message: "\"invalid\" is an invalid value as executor_memory, must be one of: 28g, 56g, 112g, 224g, 400g",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 21},
+						End:      hcl.Pos{Line: 3, Column: 30},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricSparkEnvironmentSettingsInvalidExecutorMemory()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricSparkEnvironmentSettingsInvalidRuntimeVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "enum value 1.1",
+			content: `
+resource "fabric_spark_environment_settings" "example" {
+  runtime_version = "1.1"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 1.2",
+			content: `
+resource "fabric_spark_environment_settings" "example" {
+  runtime_version = "1.2"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "enum value 1.3",
+			content: `
+resource "fabric_spark_environment_settings" "example" {
+  runtime_version = "1.3"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not in enum",
+			content: `
+resource "fabric_spark_environment_settings" "example" {
+  runtime_version = "invalid"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricSparkEnvironmentSettingsInvalidRuntimeVersion(),
+					Message: "\"invalid\" is an invalid value as runtime_version, must be one of: 1.1, 1.2, 1.3",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 21},
+						End:      hcl.Pos{Line: 3, Column: 30},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricSparkEnvironmentSettingsInvalidRuntimeVersion()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricSparkJobDefinitionDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricSparkJobDefinitionDataSourceConstraintLookup() *FabricSparkJobDefinitionDataSourceConstraintLookup {
+	return &FabricSparkJobDefinitionDataSourceConstraintLookup{}
+}
+
+func (r *FabricSparkJobDefinitionDataSourceConstraintLookup) Name() string {
+	return "fabric_spark_job_definition_data_source_constraint_lookup"
+}
+func (r *FabricSparkJobDefinitionDataSourceConstraintLookup) Enabled() bool { return true }
+func (r *FabricSparkJobDefinitionDataSourceConstraintLookup) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricSparkJobDefinitionDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/sparkjobdefinition/definitions.json"
+}
+
+func (r *FabricSparkJobDefinitionDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_spark_job_definition" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
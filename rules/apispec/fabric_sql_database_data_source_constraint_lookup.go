@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricSQLDatabaseDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricSQLDatabaseDataSourceConstraintLookup() *FabricSQLDatabaseDataSourceConstraintLookup {
+	return &FabricSQLDatabaseDataSourceConstraintLookup{}
+}
+
+func (r *FabricSQLDatabaseDataSourceConstraintLookup) Name() string {
+	return "fabric_sql_database_data_source_constraint_lookup"
+}
+func (r *FabricSQLDatabaseDataSourceConstraintLookup) Enabled() bool             { return true }
+func (r *FabricSQLDatabaseDataSourceConstraintLookup) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricSQLDatabaseDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/sqlDatabase/definitions.json"
+}
+
+func (r *FabricSQLDatabaseDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_sql_database" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
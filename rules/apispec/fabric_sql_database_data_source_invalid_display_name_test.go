@@ -0,0 +1,77 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricSQLDatabaseDataSourceInvalidDisplayName(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "valid value",
+			content: `
+data "fabric_sql_database" "example" {
+  display_name = "example"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "value not matching pattern",
+			content: `
+data "fabric_sql_database" "example" {
+  display_name = "/example"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricSQLDatabaseDataSourceInvalidDisplayName(),
+					Message: "display_name \"/example\" must not contain any of the characters / \\ : * ? \" < > | # % (invalid characters: '/')",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 28},
+					},
+				},
+			},
+		},
+		{
+			name: "reserved word master",
+			content: `
+data "fabric_sql_database" "example" {
+  display_name = "master"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricSQLDatabaseDataSourceInvalidDisplayName(),
+					Message: "\"master\" is a reserved name and cannot be used as display_name",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 26},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricSQLDatabaseDataSourceInvalidDisplayName()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricVariableLibraryDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricVariableLibraryDataSourceConstraintLookup() *FabricVariableLibraryDataSourceConstraintLookup {
+	return &FabricVariableLibraryDataSourceConstraintLookup{}
+}
+
+func (r *FabricVariableLibraryDataSourceConstraintLookup) Name() string {
+	return "fabric_variable_library_data_source_constraint_lookup"
+}
+func (r *FabricVariableLibraryDataSourceConstraintLookup) Enabled() bool { return true }
+func (r *FabricVariableLibraryDataSourceConstraintLookup) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricVariableLibraryDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/variableLibrary/definitions.json"
+}
+
+func (r *FabricVariableLibraryDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_variable_library" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -60,7 +60,6 @@ func (r *FabricVariableLibraryInvalidDescription) Check(runner tflint.Runner) er
 				return err
 			}
 		}
-		// TODO: add pattern/enum checks if needed
 	}
 
 	return nil
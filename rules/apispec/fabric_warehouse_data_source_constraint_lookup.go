@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricWarehouseDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricWarehouseDataSourceConstraintLookup() *FabricWarehouseDataSourceConstraintLookup {
+	return &FabricWarehouseDataSourceConstraintLookup{}
+}
+
+func (r *FabricWarehouseDataSourceConstraintLookup) Name() string {
+	return "fabric_warehouse_data_source_constraint_lookup"
+}
+func (r *FabricWarehouseDataSourceConstraintLookup) Enabled() bool             { return true }
+func (r *FabricWarehouseDataSourceConstraintLookup) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricWarehouseDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/warehouse/definitions.json"
+}
+
+func (r *FabricWarehouseDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_warehouse" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
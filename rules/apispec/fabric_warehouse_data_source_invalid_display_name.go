@@ -0,0 +1,92 @@
+package apispec
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricWarehouseDataSourceInvalidDisplayName struct{ tflint.DefaultRule }
+
+func NewFabricWarehouseDataSourceInvalidDisplayName() *FabricWarehouseDataSourceInvalidDisplayName {
+	return &FabricWarehouseDataSourceInvalidDisplayName{}
+}
+
+func (r *FabricWarehouseDataSourceInvalidDisplayName) Name() string {
+	return "fabric_warehouse_data_source_invalid_display_name"
+}
+func (r *FabricWarehouseDataSourceInvalidDisplayName) Enabled() bool             { return true }
+func (r *FabricWarehouseDataSourceInvalidDisplayName) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricWarehouseDataSourceInvalidDisplayName) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/warehouse/definitions.json"
+}
+
+func (r *FabricWarehouseDataSourceInvalidDisplayName) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "data",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "display_name"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	pattern := regexp.MustCompile("^[^/\\\\:*?\"<>|#%]+$")
+	allowedCharacter := regexp.MustCompile("^[^/\\\\:*?\"<>|#%]$")
+
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_warehouse" {
+			continue
+		}
+		attr, ok := block.Body.Attributes["display_name"]
+		if !ok {
+			continue
+		}
+
+		var v string
+		if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
+			continue
+		}
+		if !pattern.MatchString(v) {
+			message := fmt.Sprintf("%s %q %s", "display_name", v, "must not contain any of the characters / \\ : * ? \" < > | # %")
+			// List each offending character once, in order of appearance
+			var invalid []string
+			seen := make(map[rune]bool)
+			for _, c := range v {
+				if !seen[c] && !allowedCharacter.MatchString(string(c)) {
+					seen[c] = true
+					invalid = append(invalid, fmt.Sprintf("%q", c))
+				}
+			}
+			if len(invalid) > 0 {
+				message = fmt.Sprintf("%s (invalid characters: %s)", message, strings.Join(invalid, ", "))
+			}
+			if err := runner.EmitIssue(r, message, attr.Expr.Range()); err != nil {
+				return err
+			}
+		}
+		for _, reserved := range []string{"master", "model", "msdb", "tempdb"} {
+			if strings.EqualFold(v, reserved) {
+				if err := runner.EmitIssue(r,
+					fmt.Sprintf("%q is a reserved name and cannot be used as %s", v, "display_name"),
+					attr.Expr.Range()); err != nil {
+					return err
+				}
+				break
+			}
+		}
+	}
+
+	return nil
+}
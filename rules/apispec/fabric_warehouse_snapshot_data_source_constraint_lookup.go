@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricWarehouseSnapshotDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricWarehouseSnapshotDataSourceConstraintLookup() *FabricWarehouseSnapshotDataSourceConstraintLookup {
+	return &FabricWarehouseSnapshotDataSourceConstraintLookup{}
+}
+
+func (r *FabricWarehouseSnapshotDataSourceConstraintLookup) Name() string {
+	return "fabric_warehouse_snapshot_data_source_constraint_lookup"
+}
+func (r *FabricWarehouseSnapshotDataSourceConstraintLookup) Enabled() bool { return true }
+func (r *FabricWarehouseSnapshotDataSourceConstraintLookup) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricWarehouseSnapshotDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/warehouseSnapshot/definitions.json"
+}
+
+func (r *FabricWarehouseSnapshotDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_warehouse_snapshot" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -60,7 +60,6 @@ func (r *FabricWarehouseSnapshotInvalidDescription) Check(runner tflint.Runner)
 				return err
 			}
 		}
-		// TODO: add pattern/enum checks if needed
 	}
 
 	return nil
@@ -0,0 +1,42 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricWorkspaceDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricWorkspaceDataSourceConstraintLookup() *FabricWorkspaceDataSourceConstraintLookup {
+	return &FabricWorkspaceDataSourceConstraintLookup{}
+}
+
+func (r *FabricWorkspaceDataSourceConstraintLookup) Name() string {
+	return "fabric_workspace_data_source_constraint_lookup"
+}
+func (r *FabricWorkspaceDataSourceConstraintLookup) Enabled() bool             { return true }
+func (r *FabricWorkspaceDataSourceConstraintLookup) Severity() tflint.Severity { return tflint.ERROR }
+func (r *FabricWorkspaceDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/platform.json"
+}
+
+func (r *FabricWorkspaceDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "display_name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "display_name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_workspace" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package apispec
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricWorkspaceManagedPrivateEndpointDataSourceConstraintLookup struct{ tflint.DefaultRule }
+
+func NewFabricWorkspaceManagedPrivateEndpointDataSourceConstraintLookup() *FabricWorkspaceManagedPrivateEndpointDataSourceConstraintLookup {
+	return &FabricWorkspaceManagedPrivateEndpointDataSourceConstraintLookup{}
+}
+
+func (r *FabricWorkspaceManagedPrivateEndpointDataSourceConstraintLookup) Name() string {
+	return "fabric_workspace_managed_private_endpoint_data_source_constraint_lookup"
+}
+func (r *FabricWorkspaceManagedPrivateEndpointDataSourceConstraintLookup) Enabled() bool { return true }
+func (r *FabricWorkspaceManagedPrivateEndpointDataSourceConstraintLookup) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricWorkspaceManagedPrivateEndpointDataSourceConstraintLookup) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/managedPrivateEndpoint.json"
+}
+
+func (r *FabricWorkspaceManagedPrivateEndpointDataSourceConstraintLookup) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(nestedDataSchema("id", "name"), nil)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"id", "name"}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_workspace_managed_private_endpoint" {
+			continue
+		}
+
+		checker := newConstraintChecker(runner, block)
+		violations := checker.checkOneOf(paths)
+		if err := emitConstraintViolations(runner, r, violations, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
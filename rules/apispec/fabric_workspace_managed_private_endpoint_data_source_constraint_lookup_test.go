@@ -0,0 +1,77 @@
+package apispec
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+func TestFabricWorkspaceManagedPrivateEndpointDataSourceConstraintLookup(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected helper.Issues
+	}{
+		{
+			name: "only id set",
+			content: `
+data "fabric_workspace_managed_private_endpoint" "example" {
+  id = "example"
+}
+`,
+			expected: helper.Issues{},
+		},
+		{
+			name: "none set",
+			content: `
+data "fabric_workspace_managed_private_endpoint" "example" {
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricWorkspaceManagedPrivateEndpointDataSourceConstraintLookup(),
+					Message: "Exactly one of id, name must be set",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 59},
+					},
+				},
+			},
+		},
+		{
+			name: "id and name set",
+			content: `
+data "fabric_workspace_managed_private_endpoint" "example" {
+  id = "example"
+  name = "example"
+}
+`,
+			expected: helper.Issues{
+				{
+					Rule:    NewFabricWorkspaceManagedPrivateEndpointDataSourceConstraintLookup(),
+					Message: "Exactly one of id, name must be set",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 10},
+						End:      hcl.Pos{Line: 4, Column: 19},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewFabricWorkspaceManagedPrivateEndpointDataSourceConstraintLookup()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			helper.AssertIssues(t, tt.expected, runner.Issues)
+		})
+	}
+}
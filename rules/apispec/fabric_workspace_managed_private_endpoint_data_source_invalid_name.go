@@ -0,0 +1,91 @@
+package apispec
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricWorkspaceManagedPrivateEndpointDataSourceInvalidName struct{ tflint.DefaultRule }
+
+func NewFabricWorkspaceManagedPrivateEndpointDataSourceInvalidName() *FabricWorkspaceManagedPrivateEndpointDataSourceInvalidName {
+	return &FabricWorkspaceManagedPrivateEndpointDataSourceInvalidName{}
+}
+
+func (r *FabricWorkspaceManagedPrivateEndpointDataSourceInvalidName) Name() string {
+	return "fabric_workspace_managed_private_endpoint_data_source_invalid_name"
+}
+func (r *FabricWorkspaceManagedPrivateEndpointDataSourceInvalidName) Enabled() bool { return true }
+func (r *FabricWorkspaceManagedPrivateEndpointDataSourceInvalidName) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricWorkspaceManagedPrivateEndpointDataSourceInvalidName) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/managedPrivateEndpoint.json"
+}
+
+func (r *FabricWorkspaceManagedPrivateEndpointDataSourceInvalidName) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "data",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "name"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	pattern := regexp.MustCompile("^[a-zA-Z0-9]([a-zA-Z0-9_.-]*[a-zA-Z0-9_])?$")
+	allowedCharacter := regexp.MustCompile("^[a-zA-Z0-9_.-]$")
+
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_workspace_managed_private_endpoint" {
+			continue
+		}
+		attr, ok := block.Body.Attributes["name"]
+		if !ok {
+			continue
+		}
+
+		var v string
+		if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
+			continue
+		}
+		if len(v) > 64 {
+			if err := runner.EmitIssue(r,
+				fmt.Sprintf("%s exceeds max length %d", "name", 64),
+				attr.Expr.Range()); err != nil {
+				return err
+			}
+		}
+		if !pattern.MatchString(v) {
+			message := fmt.Sprintf("%s %q %s", "name", v, "must start with a letter or number, end with a letter, number or underscore, and contain only letters, numbers, underscores, periods and hyphens")
+			// List each offending character once, in order of appearance
+			var invalid []string
+			seen := make(map[rune]bool)
+			for _, c := range v {
+				if !seen[c] && !allowedCharacter.MatchString(string(c)) {
+					seen[c] = true
+					invalid = append(invalid, fmt.Sprintf("%q", c))
+				}
+			}
+			if len(invalid) > 0 {
+				message = fmt.Sprintf("%s (invalid characters: %s)", message, strings.Join(invalid, ", "))
+			}
+			if err := runner.EmitIssue(r, message, attr.Expr.Range()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
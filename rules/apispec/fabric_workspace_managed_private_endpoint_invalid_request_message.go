@@ -0,0 +1,68 @@
+package apispec
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+type FabricWorkspaceManagedPrivateEndpointInvalidRequestMessage struct{ tflint.DefaultRule }
+
+func NewFabricWorkspaceManagedPrivateEndpointInvalidRequestMessage() *FabricWorkspaceManagedPrivateEndpointInvalidRequestMessage {
+	return &FabricWorkspaceManagedPrivateEndpointInvalidRequestMessage{}
+}
+
+func (r *FabricWorkspaceManagedPrivateEndpointInvalidRequestMessage) Name() string {
+	return "fabric_workspace_managed_private_endpoint_invalid_request_message"
+}
+func (r *FabricWorkspaceManagedPrivateEndpointInvalidRequestMessage) Enabled() bool { return true }
+func (r *FabricWorkspaceManagedPrivateEndpointInvalidRequestMessage) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+func (r *FabricWorkspaceManagedPrivateEndpointInvalidRequestMessage) Link() string {
+	return "https://github.com/microsoft/fabric-rest-api-specs/tree/main/platform/definitions/managedPrivateEndpoint.json"
+}
+
+func (r *FabricWorkspaceManagedPrivateEndpointInvalidRequestMessage) Check(runner tflint.Runner) error {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "request_message"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "fabric_workspace_managed_private_endpoint" {
+			continue
+		}
+		attr, ok := block.Body.Attributes["request_message"]
+		if !ok {
+			continue
+		}
+
+		var v string
+		if err := runner.EvaluateExpr(attr.Expr, &v, nil); err != nil {
+			continue
+		}
+		if len(v) > 140 {
+			if err := runner.EmitIssue(r,
+				fmt.Sprintf("%s exceeds max length %d", "request_message", 140),
+				attr.Expr.Range()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
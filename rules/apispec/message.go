@@ -0,0 +1,13 @@
+package apispec
+
+import (
+	"fmt"
+	"strings"
+)
+
+// issueMessage fills the placeholders of a message set in a mapping: {value} is the attribute value, {limit} the
+// constraint the value breaks, e.g. the max length or the allowed values, and {actual} what the value has instead,
+// its length for length constraints and the value itself otherwise
+func issueMessage(message, value string, limit, actual any) string {
+	return strings.NewReplacer("{value}", value, "{limit}", fmt.Sprint(limit), "{actual}", fmt.Sprint(actual)).Replace(message)
+}
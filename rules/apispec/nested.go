@@ -0,0 +1,124 @@
+package apispec
+
+import (
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// The provider defines nested objects such as target or credential_details as attributes (target = { ... }),
+// but many configurations write them as blocks (target { ... }). These helpers read both forms.
+
+// nestedSchema returns a resource schema for the dotted attribute paths, e.g. "target.onelake.path"
+// Every element of a path is read both as an attribute and as a block
+func nestedSchema(paths ...string) *hclext.BodySchema {
+	return nestedTopLevelSchema("resource", paths)
+}
+
+// nestedDataSchema returns a data source schema for the dotted attribute paths, like nestedSchema
+func nestedDataSchema(paths ...string) *hclext.BodySchema {
+	return nestedTopLevelSchema("data", paths)
+}
+
+func nestedTopLevelSchema(blockType string, paths []string) *hclext.BodySchema {
+	split := make([][]string, 0, len(paths))
+	for _, path := range paths {
+		split = append(split, strings.Split(path, "."))
+	}
+	return &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       blockType,
+				LabelNames: []string{"type", "name"},
+				Body:       nestedBodySchema(split),
+			},
+		},
+	}
+}
+
+func nestedBodySchema(paths [][]string) *hclext.BodySchema {
+	schema := &hclext.BodySchema{}
+	children := make(map[string][][]string)
+	var order []string
+
+	for _, path := range paths {
+		name := path[0]
+		if _, exists := children[name]; !exists {
+			order = append(order, name)
+			schema.Attributes = append(schema.Attributes, hclext.AttributeSchema{Name: name})
+			children[name] = nil
+		}
+		if len(path) > 1 {
+			children[name] = append(children[name], path[1:])
+		}
+	}
+
+	for _, name := range order {
+		schema.Blocks = append(schema.Blocks, hclext.BlockSchema{Type: name, Body: nestedBodySchema(children[name])})
+	}
+	return schema
+}
+
+// nestedAttributes returns every instance of the attribute at path, e.g. one per git_provider_details block
+// Instances are found in nested blocks, object constructors and lists of objects
+// body must have been fetched with nestedSchema
+func nestedAttributes(body *hclext.BodyContent, path []string) []*hclext.Attribute {
+	if attr, exists := body.Attributes[path[0]]; exists {
+		if len(path) == 1 {
+			return []*hclext.Attribute{attr}
+		}
+		return objectAttributes(attr.Expr, path[1:])
+	}
+	if len(path) == 1 {
+		return nil
+	}
+
+	var attrs []*hclext.Attribute
+	for _, block := range body.Blocks.OfType(path[0]) {
+		attrs = append(attrs, nestedAttributes(block.Body, path[1:])...)
+	}
+	return attrs
+}
+
+// objectAttributes returns every instance of the attribute at path inside an object or list of objects
+// Values that are not written as constructors, e.g. var.target, are skipped
+func objectAttributes(expr hcl.Expression, path []string) []*hclext.Attribute {
+	switch expr := expr.(type) {
+	case *hclsyntax.ObjectConsExpr:
+		for _, item := range expr.Items {
+			if objectKeyName(item.KeyExpr) != path[0] {
+				continue
+			}
+			if len(path) == 1 {
+				return []*hclext.Attribute{{
+					Name:  path[0],
+					Expr:  item.ValueExpr,
+					Range: hcl.RangeBetween(item.KeyExpr.Range(), item.ValueExpr.Range()),
+				}}
+			}
+			return objectAttributes(item.ValueExpr, path[1:])
+		}
+	case *hclsyntax.TupleConsExpr:
+		var attrs []*hclext.Attribute
+		for _, element := range expr.Exprs {
+			attrs = append(attrs, objectAttributes(element, path)...)
+		}
+		return attrs
+	}
+	return nil
+}
+
+// objectKeyName returns the name of an object key written as an identifier or a string
+func objectKeyName(expr hclsyntax.Expression) string {
+	if name := hcl.ExprAsKeyword(expr); name != "" {
+		return name
+	}
+	value, diags := expr.Value(nil)
+	if diags.HasErrors() || !value.IsKnown() || value.Type() != cty.String {
+		return ""
+	}
+	return value.AsString()
+}
@@ -7,47 +7,100 @@ import (
 // Rules returns all generated rules
 func Rules() []tflint.Rule {
 	return []tflint.Rule{
+		NewFabricActivatorDataSourceConstraintLookup(),
 		NewFabricActivatorInvalidDescription(),
+		NewFabricApacheAirflowJobDataSourceConstraintLookup(),
 		NewFabricApacheAirflowJobInvalidDescription(),
+		NewFabricConnectionConstraintBasicCredentialsPasswordWoRequired(),
+		NewFabricConnectionConstraintCredentialTypeBasic(),
+		NewFabricConnectionConstraintCredentialTypeKey(),
+		NewFabricConnectionConstraintCredentialTypeServicePrincipal(),
+		NewFabricConnectionConstraintCredentialTypeSharedAccessSignature(),
+		NewFabricConnectionConstraintGatewayIDForbidden(),
+		NewFabricConnectionConstraintGatewayIDRequired(),
+		NewFabricConnectionCredentialDetailsInvalidConnectionEncryption(),
+		NewFabricConnectionCredentialDetailsInvalidCredentialType(),
+		NewFabricConnectionCredentialDetailsInvalidSingleSignOnType(),
 		NewFabricConnectionInvalidConnectivityType(),
 		NewFabricConnectionInvalidDisplayName(),
 		NewFabricConnectionInvalidPrivacyLevel(),
+		NewFabricCopyJobDataSourceConstraintLookup(),
+		NewFabricCopyJobDataSourceInvalidDisplayName(),
 		NewFabricCopyJobInvalidDescription(),
 		NewFabricCopyJobInvalidDisplayName(),
+		NewFabricDataPipelineDataSourceConstraintLookup(),
+		NewFabricDataPipelineDataSourceInvalidDisplayName(),
 		NewFabricDataPipelineInvalidDescription(),
 		NewFabricDataPipelineInvalidDisplayName(),
+		NewFabricDataflowDataSourceConstraintLookup(),
+		NewFabricDataflowDataSourceInvalidDisplayName(),
 		NewFabricDataflowInvalidDescription(),
 		NewFabricDataflowInvalidDisplayName(),
+		NewFabricDeploymentPipelineDataSourceConstraintLookup(),
+		NewFabricDeploymentPipelineDataSourceInvalidDisplayName(),
 		NewFabricDeploymentPipelineInvalidDescription(),
 		NewFabricDeploymentPipelineInvalidDisplayName(),
+		NewFabricDeploymentPipelineRoleAssignmentInvalidRole(),
+		NewFabricDeploymentPipelineRoleAssignmentPrincipalInvalidType(),
+		NewFabricDigitalTwinBuilderDataSourceConstraintLookup(),
 		NewFabricDigitalTwinBuilderInvalidDescription(),
 		NewFabricDomainInvalidDescription(),
 		NewFabricDomainInvalidDisplayName(),
 		NewFabricDomainInvalidParentDomainID(),
+		NewFabricDomainRoleAssignmentsInvalidRole(),
+		NewFabricDomainRoleAssignmentsPrincipalsInvalidType(),
+		NewFabricEnvironmentDataSourceConstraintLookup(),
 		NewFabricEnvironmentInvalidDescription(),
+		NewFabricEventhouseDataSourceConstraintLookup(),
+		NewFabricEventhouseDataSourceInvalidDisplayName(),
 		NewFabricEventhouseInvalidDescription(),
 		NewFabricEventhouseInvalidDisplayName(),
 		NewFabricEventhouseInvalidFormat(),
+		NewFabricEventstreamDataSourceConstraintLookup(),
+		NewFabricEventstreamDataSourceInvalidDisplayName(),
 		NewFabricEventstreamInvalidDescription(),
 		NewFabricEventstreamInvalidDisplayName(),
 		NewFabricFolderInvalidDisplayName(),
 		NewFabricFolderInvalidParentFolderID(),
+		NewFabricGatewayDataSourceConstraintLookup(),
 		NewFabricGatewayInvalidType(),
+		NewFabricGatewayRoleAssignmentInvalidRole(),
+		NewFabricGatewayRoleAssignmentPrincipalInvalidType(),
+		NewFabricGraphqlAPIDataSourceConstraintLookup(),
 		NewFabricGraphqlAPIInvalidDescription(),
+		NewFabricKQLDashboardDataSourceConstraintLookup(),
 		NewFabricKQLDashboardInvalidDescription(),
+		NewFabricKQLDatabaseDataSourceConstraintLookup(),
+		NewFabricKQLDatabaseDataSourceInvalidDisplayName(),
 		NewFabricKQLDatabaseInvalidDescription(),
+		NewFabricKQLDatabaseInvalidDisplayName(),
+		NewFabricKQLQuerysetDataSourceConstraintLookup(),
 		NewFabricKQLQuerysetInvalidDescription(),
+		NewFabricLakehouseDataSourceConstraintLookup(),
+		NewFabricLakehouseDataSourceInvalidDisplayName(),
 		NewFabricLakehouseInvalidDescription(),
 		NewFabricLakehouseInvalidDisplayName(),
+		NewFabricMirroredDatabaseDataSourceConstraintLookup(),
 		NewFabricMirroredDatabaseInvalidDescription(),
+		NewFabricMlExperimentDataSourceConstraintLookup(),
 		NewFabricMlExperimentInvalidDescription(),
+		NewFabricMlModelDataSourceConstraintLookup(),
 		NewFabricMlModelInvalidDescription(),
+		NewFabricMountedDataFactoryDataSourceConstraintLookup(),
 		NewFabricMountedDataFactoryInvalidDescription(),
+		NewFabricNotebookDataSourceConstraintLookup(),
+		NewFabricNotebookDataSourceInvalidDisplayName(),
 		NewFabricNotebookInvalidDescription(),
 		NewFabricNotebookInvalidDisplayName(),
 		NewFabricReportInvalidDescription(),
+		NewFabricSQLDatabaseDataSourceConstraintLookup(),
+		NewFabricSQLDatabaseDataSourceInvalidDisplayName(),
 		NewFabricSQLDatabaseInvalidDescription(),
+		NewFabricSQLDatabaseInvalidDisplayName(),
 		NewFabricSemanticModelInvalidDescription(),
+		NewFabricShortcutConstraintTargetDestination(),
+		NewFabricShortcutTargetOnelakeInvalidPath(),
+		NewFabricSparkCustomPoolDataSourceConstraintLookup(),
 		NewFabricSparkCustomPoolInvalidNodeFamily(),
 		NewFabricSparkCustomPoolInvalidNodeSize(),
 		NewFabricSparkEnvironmentSettingsInvalidDriverCores(),
@@ -55,13 +108,26 @@ func Rules() []tflint.Rule {
 		NewFabricSparkEnvironmentSettingsInvalidExecutorCores(),
 		NewFabricSparkEnvironmentSettingsInvalidExecutorMemory(),
 		NewFabricSparkEnvironmentSettingsInvalidRuntimeVersion(),
+		NewFabricSparkJobDefinitionDataSourceConstraintLookup(),
+		NewFabricSparkJobDefinitionDataSourceInvalidDisplayName(),
 		NewFabricSparkJobDefinitionInvalidDescription(),
 		NewFabricSparkJobDefinitionInvalidDisplayName(),
+		NewFabricVariableLibraryDataSourceConstraintLookup(),
 		NewFabricVariableLibraryInvalidDescription(),
+		NewFabricWarehouseDataSourceConstraintLookup(),
+		NewFabricWarehouseDataSourceInvalidDisplayName(),
 		NewFabricWarehouseInvalidDescription(),
+		NewFabricWarehouseInvalidDisplayName(),
+		NewFabricWarehouseSnapshotDataSourceConstraintLookup(),
 		NewFabricWarehouseSnapshotInvalidDescription(),
+		NewFabricWorkspaceDataSourceConstraintLookup(),
+		NewFabricWorkspaceDataSourceInvalidDisplayName(),
 		NewFabricWorkspaceInvalidCapacityID(),
 		NewFabricWorkspaceInvalidDescription(),
 		NewFabricWorkspaceInvalidDisplayName(),
+		NewFabricWorkspaceManagedPrivateEndpointDataSourceConstraintLookup(),
+		NewFabricWorkspaceManagedPrivateEndpointDataSourceInvalidName(),
+		NewFabricWorkspaceManagedPrivateEndpointInvalidName(),
+		NewFabricWorkspaceManagedPrivateEndpointInvalidRequestMessage(),
 	}
 }
@@ -0,0 +1,215 @@
+package rules
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// deploymentPipelineStageAttributes are the attributes of a deployment pipeline stage
+var deploymentPipelineStageAttributes = []string{"display_name", "description", "is_public", "workspace_id"}
+
+// deploymentPipelineStagesSchema returns the fabric_deployment_pipeline schema read by readDeploymentPipelineStages
+// The provider defines stages as a list of objects; stages blocks are still read for older configurations
+func deploymentPipelineStagesSchema() *hclext.BodySchema {
+	stageAttributes := make([]hclext.AttributeSchema, 0, len(deploymentPipelineStageAttributes))
+	for _, name := range deploymentPipelineStageAttributes {
+		stageAttributes = append(stageAttributes, hclext.AttributeSchema{Name: name})
+	}
+
+	return &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "stages"},
+			{Name: "for_each"},
+		},
+		Blocks: []hclext.BlockSchema{
+			{Type: "stages", Body: &hclext.BodySchema{Attributes: stageAttributes}},
+		},
+	}
+}
+
+// deploymentPipelineStage is one stage of a fabric_deployment_pipeline
+type deploymentPipelineStage struct {
+	// values holds the attribute values that are known statically
+	values map[string]cty.Value
+	// exprs holds the attribute expressions when the stage is written out literally
+	exprs map[string]hcl.Expression
+	// ranges holds the attribute ranges, when the attribute can be located
+	ranges map[string]hcl.Range
+	rng    hcl.Range
+}
+
+func newDeploymentPipelineStage(rng hcl.Range) *deploymentPipelineStage {
+	return &deploymentPipelineStage{
+		values: make(map[string]cty.Value),
+		exprs:  make(map[string]hcl.Expression),
+		ranges: make(map[string]hcl.Range),
+		rng:    rng,
+	}
+}
+
+// String returns the value of a string attribute, and whether it is known
+func (s *deploymentPipelineStage) String(name string) (string, bool) {
+	value, exists := s.values[name]
+	if !exists || value.Type() != cty.String {
+		return "", false
+	}
+	return value.AsString(), true
+}
+
+// Bool returns the value of a bool attribute, and whether it is known
+func (s *deploymentPipelineStage) Bool(name string) (bool, bool) {
+	value, exists := s.values[name]
+	if !exists || value.Type() != cty.Bool {
+		return false, false
+	}
+	return value.True(), true
+}
+
+// Range returns the range of an attribute, or of the whole stage when the attribute cannot be located
+func (s *deploymentPipelineStage) Range(name string) hcl.Range {
+	if rng, exists := s.ranges[name]; exists {
+		return rng
+	}
+	return s.rng
+}
+
+// readDeploymentPipelineStages returns the stages of a fabric_deployment_pipeline fetched with deploymentPipelineStagesSchema
+// It returns false when the number of stages is not known statically
+func readDeploymentPipelineStages(runner tflint.Runner, pipeline *hclext.Block) ([]*deploymentPipelineStage, bool) {
+	if blocks := pipeline.Body.Blocks.OfType("stages"); len(blocks) > 0 {
+		stages := make([]*deploymentPipelineStage, 0, len(blocks))
+		for _, block := range blocks {
+			stage := newDeploymentPipelineStage(block.DefRange)
+			for name, attr := range block.Body.Attributes {
+				stage.set(runner, name, attr.Expr, attr.Range)
+			}
+			stages = append(stages, stage)
+		}
+		return stages, true
+	}
+
+	attr, exists := pipeline.Body.Attributes["stages"]
+	if !exists || attr.Expr == nil {
+		return nil, true
+	}
+
+	switch expr := attr.Expr.(type) {
+	case *hclsyntax.TupleConsExpr:
+		stages := make([]*deploymentPipelineStage, 0, len(expr.Exprs))
+		for _, element := range expr.Exprs {
+			stage := newDeploymentPipelineStage(element.Range())
+			if object, ok := element.(*hclsyntax.ObjectConsExpr); ok {
+				for _, item := range object.Items {
+					if name := objectKeyName(item.KeyExpr); name != "" {
+						stage.set(runner, name, item.ValueExpr, hcl.RangeBetween(item.KeyExpr.Range(), item.ValueExpr.Range()))
+					}
+				}
+			} else {
+				var value cty.Value
+				if err := runner.EvaluateExpr(element, &value, nil); err == nil {
+					stage.setObject(value)
+				}
+			}
+			stages = append(stages, stage)
+		}
+		return stages, true
+	case *hclsyntax.ForExpr:
+		return readDeploymentPipelineStagesFor(runner, expr)
+	default:
+		// stages = local.stages and similar
+		var value cty.Value
+		if err := runner.EvaluateExpr(attr.Expr, &value, nil); err != nil || !value.IsKnown() || value.IsNull() || !value.CanIterateElements() {
+			return nil, false
+		}
+		stages := make([]*deploymentPipelineStage, 0, value.LengthInt())
+		for it := value.ElementIterator(); it.Next(); {
+			_, element := it.Element()
+			stage := newDeploymentPipelineStage(attr.Range)
+			stage.setObject(element)
+			stages = append(stages, stage)
+		}
+		return stages, true
+	}
+}
+
+// readDeploymentPipelineStagesFor reads stages built with a for expression
+// The collection is evaluated by the runner, and each stage is evaluated with only the iterator variables in scope,
+// so stage attributes that refer to anything else are unknown
+func readDeploymentPipelineStagesFor(runner tflint.Runner, expr *hclsyntax.ForExpr) ([]*deploymentPipelineStage, bool) {
+	if expr.KeyExpr != nil {
+		// An object, not a list of stages
+		return nil, false
+	}
+
+	var collection cty.Value
+	if err := runner.EvaluateExpr(expr.CollExpr, &collection, nil); err != nil || !collection.IsKnown() || collection.IsNull() || !collection.CanIterateElements() {
+		return nil, false
+	}
+
+	var stages []*deploymentPipelineStage
+	for it := collection.ElementIterator(); it.Next(); {
+		key, element := it.Element()
+		ctx := &hcl.EvalContext{Variables: map[string]cty.Value{expr.ValVar: element}}
+		if expr.KeyVar != "" {
+			ctx.Variables[expr.KeyVar] = key
+		}
+
+		if expr.CondExpr != nil {
+			include, diags := expr.CondExpr.Value(ctx)
+			if diags.HasErrors() || !include.IsKnown() || include.IsNull() || include.Type() != cty.Bool {
+				return nil, false
+			}
+			if include.False() {
+				continue
+			}
+		}
+
+		stage := newDeploymentPipelineStage(expr.ValExpr.Range())
+		if object, ok := expr.ValExpr.(*hclsyntax.ObjectConsExpr); ok {
+			for _, item := range object.Items {
+				name := objectKeyName(item.KeyExpr)
+				if name == "" {
+					continue
+				}
+				stage.ranges[name] = hcl.RangeBetween(item.KeyExpr.Range(), item.ValueExpr.Range())
+				if value, diags := item.ValueExpr.Value(ctx); !diags.HasErrors() && value.IsWhollyKnown() && !value.IsNull() {
+					stage.values[name] = value
+				}
+			}
+		} else if value, diags := expr.ValExpr.Value(ctx); !diags.HasErrors() {
+			stage.setObject(value)
+		}
+		stages = append(stages, stage)
+	}
+
+	return stages, true
+}
+
+// set records the expression, range and known value of a stage attribute
+func (s *deploymentPipelineStage) set(runner tflint.Runner, name string, expr hcl.Expression, rng hcl.Range) {
+	s.exprs[name] = expr
+	s.ranges[name] = rng
+
+	var value cty.Value
+	if err := runner.EvaluateExpr(expr, &value, nil); err == nil && value.IsWhollyKnown() && !value.IsNull() {
+		s.values[name] = value
+	}
+}
+
+// setObject records the known attribute values of a stage object
+func (s *deploymentPipelineStage) setObject(object cty.Value) {
+	if !object.IsKnown() || object.IsNull() || !object.Type().IsObjectType() {
+		return
+	}
+	for _, name := range deploymentPipelineStageAttributes {
+		if !object.Type().HasAttribute(name) {
+			continue
+		}
+		if value := object.GetAttr(name); value.IsWhollyKnown() && !value.IsNull() {
+			s.values[name] = value
+		}
+	}
+}
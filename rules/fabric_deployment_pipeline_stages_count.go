@@ -3,7 +3,6 @@ package rules
 import (
 	"fmt"
 
-	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 
 	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
@@ -35,14 +34,7 @@ func (r *FabricDeploymentPipelineStagesCount) Link() string {
 }
 
 func (r *FabricDeploymentPipelineStagesCount) Check(runner tflint.Runner) error {
-	resourceContent, err := runner.GetResourceContent("fabric_deployment_pipeline", &hclext.BodySchema{
-		Blocks: []hclext.BlockSchema{
-			{
-				Type: "stages",
-				Body: &hclext.BodySchema{},
-			},
-		},
-	}, nil)
+	resourceContent, err := runner.GetResourceContent("fabric_deployment_pipeline", deploymentPipelineStagesSchema(), nil)
 	if err != nil {
 		return err
 	}
@@ -51,8 +43,11 @@ func (r *FabricDeploymentPipelineStagesCount) Check(runner tflint.Runner) error
 	const maxStages = 10
 
 	for _, resource := range resourceContent.Blocks {
-		stagesBlocks := resource.Body.Blocks.OfType("stages")
-		stageCount := len(stagesBlocks)
+		stages, known := readDeploymentPipelineStages(runner, resource)
+		if !known {
+			continue
+		}
+		stageCount := len(stages)
 
 		if stageCount < minStages {
 			if err := runner.EmitIssue(
@@ -3,7 +3,6 @@ package rules
 import (
 	"fmt"
 
-	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 
 	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
@@ -35,18 +34,7 @@ func (r *FabricDeploymentPipelineStagesDescriptionLength) Link() string {
 }
 
 func (r *FabricDeploymentPipelineStagesDescriptionLength) Check(runner tflint.Runner) error {
-	resourceContent, err := runner.GetResourceContent("fabric_deployment_pipeline", &hclext.BodySchema{
-		Blocks: []hclext.BlockSchema{
-			{
-				Type: "stages",
-				Body: &hclext.BodySchema{
-					Attributes: []hclext.AttributeSchema{
-						{Name: "description"},
-					},
-				},
-			},
-		},
-	}, nil)
+	resourceContent, err := runner.GetResourceContent("fabric_deployment_pipeline", deploymentPipelineStagesSchema(), nil)
 	if err != nil {
 		return err
 	}
@@ -54,22 +42,19 @@ func (r *FabricDeploymentPipelineStagesDescriptionLength) Check(runner tflint.Ru
 	const maxLength = 1024
 
 	for _, resource := range resourceContent.Blocks {
-		stagesBlocks := resource.Body.Blocks.OfType("stages")
+		stages, _ := readDeploymentPipelineStages(runner, resource)
 
-		for _, stage := range stagesBlocks {
-			if attr, exists := stage.Body.Attributes["description"]; exists && attr.Expr != nil {
-				var description string
-				if err := runner.EvaluateExpr(attr.Expr, &description, nil); err == nil && description != "" {
-					if len(description) > maxLength {
-						if err := runner.EmitIssue(
-							r,
-							fmt.Sprintf("Stage description must not exceed %d characters (current: %d)", maxLength, len(description)),
-							attr.Range,
-						); err != nil {
-							return err
-						}
-					}
-				}
+		for _, stage := range stages {
+			description, known := stage.String("description")
+			if !known || len(description) <= maxLength {
+				continue
+			}
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Stage description must not exceed %d characters (current: %d)", maxLength, len(description)),
+				stage.Range("description"),
+			); err != nil {
+				return err
 			}
 		}
 	}
@@ -3,7 +3,6 @@ package rules
 import (
 	"fmt"
 
-	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 
 	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
@@ -35,18 +34,7 @@ func (r *FabricDeploymentPipelineStagesDisplayNameLength) Link() string {
 }
 
 func (r *FabricDeploymentPipelineStagesDisplayNameLength) Check(runner tflint.Runner) error {
-	resourceContent, err := runner.GetResourceContent("fabric_deployment_pipeline", &hclext.BodySchema{
-		Blocks: []hclext.BlockSchema{
-			{
-				Type: "stages",
-				Body: &hclext.BodySchema{
-					Attributes: []hclext.AttributeSchema{
-						{Name: "display_name"},
-					},
-				},
-			},
-		},
-	}, nil)
+	resourceContent, err := runner.GetResourceContent("fabric_deployment_pipeline", deploymentPipelineStagesSchema(), nil)
 	if err != nil {
 		return err
 	}
@@ -54,22 +42,19 @@ func (r *FabricDeploymentPipelineStagesDisplayNameLength) Check(runner tflint.Ru
 	const maxLength = 256
 
 	for _, resource := range resourceContent.Blocks {
-		stagesBlocks := resource.Body.Blocks.OfType("stages")
+		stages, _ := readDeploymentPipelineStages(runner, resource)
 
-		for _, stage := range stagesBlocks {
-			if attr, exists := stage.Body.Attributes["display_name"]; exists && attr.Expr != nil {
-				var name string
-				if err := runner.EvaluateExpr(attr.Expr, &name, nil); err == nil && name != "" {
-					if len(name) > maxLength {
-						if err := runner.EmitIssue(
-							r,
-							fmt.Sprintf("Stage display_name must not exceed %d characters (current: %d)", maxLength, len(name)),
-							attr.Range,
-						); err != nil {
-							return err
-						}
-					}
-				}
+		for _, stage := range stages {
+			name, known := stage.String("display_name")
+			if !known || len(name) <= maxLength {
+				continue
+			}
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Stage display_name must not exceed %d characters (current: %d)", maxLength, len(name)),
+				stage.Range("display_name"),
+			); err != nil {
+				return err
 			}
 		}
 	}
@@ -0,0 +1,226 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+)
+
+// Values of the public_stages option
+const (
+	publicStagesAny     = "any"
+	publicStagesNone    = "none"
+	publicStagesNotLast = "not_last"
+)
+
+// FabricDeploymentPipelineStagesSemantics validates the stages of deployment pipelines
+// Stage names must be unique within a pipeline and a workspace can be assigned to only one stage.
+// Public stages and first/last stage names can be enforced through the rule configuration
+type FabricDeploymentPipelineStagesSemantics struct {
+	tflint.DefaultRule
+}
+
+// fabricDeploymentPipelineStagesSemanticsConfig is the rule configuration
+type fabricDeploymentPipelineStagesSemanticsConfig struct {
+	PublicStages   string `hclext:"public_stages,optional"`
+	FirstStageName string `hclext:"first_stage_name,optional"`
+	LastStageName  string `hclext:"last_stage_name,optional"`
+}
+
+// assignedStage is the first stage a workspace was found in
+type assignedStage struct {
+	pipeline string
+	stage    int
+	rng      hcl.Range
+}
+
+func NewFabricDeploymentPipelineStagesSemantics() *FabricDeploymentPipelineStagesSemantics {
+	return &FabricDeploymentPipelineStagesSemantics{}
+}
+
+func (r *FabricDeploymentPipelineStagesSemantics) Name() string {
+	return "fabric_deployment_pipeline_stages_semantics"
+}
+
+func (r *FabricDeploymentPipelineStagesSemantics) Enabled() bool {
+	return true
+}
+
+func (r *FabricDeploymentPipelineStagesSemantics) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *FabricDeploymentPipelineStagesSemantics) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricDeploymentPipelineStagesSemantics) Check(runner tflint.Runner) error {
+	config := fabricDeploymentPipelineStagesSemanticsConfig{PublicStages: publicStagesAny}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	switch config.PublicStages {
+	case publicStagesAny, publicStagesNone, publicStagesNotLast:
+	default:
+		return fmt.Errorf("invalid public_stages %q for %s: must be one of %q, %q or %q",
+			config.PublicStages, r.Name(), publicStagesAny, publicStagesNone, publicStagesNotLast)
+	}
+
+	resolver, err := newReferenceResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	// Stages are compared across declarations, so count and for_each instances share a pipeline
+	noExpand := &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone}
+
+	multiInstance, err := r.multiInstanceWorkspaces(runner, noExpand)
+	if err != nil {
+		return err
+	}
+
+	pipelines, err := runner.GetResourceContent("fabric_deployment_pipeline", deploymentPipelineStagesSchema(), noExpand)
+	if err != nil {
+		return err
+	}
+
+	assigned := make(map[string]assignedStage)
+
+	for _, pipeline := range pipelines.Blocks {
+		address := strings.Join(pipeline.Labels, ".")
+		stages, known := readDeploymentPipelineStages(runner, pipeline)
+		if !known {
+			continue
+		}
+
+		names := make(map[string]int)
+		for i, stage := range stages {
+			if name, known := stage.String("display_name"); known {
+				if first, exists := names[strings.ToLower(name)]; exists {
+					if err := runner.EmitIssue(
+						r,
+						fmt.Sprintf("Stage display name '%s' is already used by stage %d of %s. Stage names must be unique within a pipeline", name, first+1, address),
+						stage.Range("display_name"),
+					); err != nil {
+						return err
+					}
+				} else {
+					names[strings.ToLower(name)] = i
+				}
+			}
+
+			workspace := r.resolveWorkspace(runner, resolver, stage, forEachExpr(pipeline), multiInstance)
+			if workspace != "" {
+				if first, exists := assigned[workspace]; exists {
+					if err := runner.EmitIssue(
+						r,
+						fmt.Sprintf("Workspace '%s' is already assigned to stage %d of %s (%s). A workspace can be assigned to only one deployment pipeline stage",
+							workspace, first.stage+1, first.pipeline, first.rng.String()),
+						stage.Range("workspace_id"),
+					); err != nil {
+						return err
+					}
+				} else {
+					assigned[workspace] = assignedStage{pipeline: address, stage: i, rng: stage.Range("workspace_id")}
+				}
+			}
+
+			if err := r.checkPublic(runner, config, stage, i == len(stages)-1); err != nil {
+				return err
+			}
+		}
+
+		if len(stages) == 0 {
+			continue
+		}
+		if err := r.checkStageName(runner, "first", config.FirstStageName, stages[0]); err != nil {
+			return err
+		}
+		if err := r.checkStageName(runner, "last", config.LastStageName, stages[len(stages)-1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkPublic reports public stages that the public_stages option does not allow
+func (r *FabricDeploymentPipelineStagesSemantics) checkPublic(runner tflint.Runner, config fabricDeploymentPipelineStagesSemanticsConfig, stage *deploymentPipelineStage, last bool) error {
+	public, known := stage.Bool("is_public")
+	if !known || !public {
+		return nil
+	}
+
+	switch {
+	case config.PublicStages == publicStagesNone:
+		return runner.EmitIssue(r, "Stage must not be public (is_public = true). Public stages are disabled by the public_stages option", stage.Range("is_public"))
+	case config.PublicStages == publicStagesNotLast && last:
+		return runner.EmitIssue(r, "The last stage must not be public (is_public = true). It usually holds production content", stage.Range("is_public"))
+	}
+	return nil
+}
+
+// checkStageName reports a first or last stage whose display name differs from the configured one
+func (r *FabricDeploymentPipelineStagesSemantics) checkStageName(runner tflint.Runner, position string, expected string, stage *deploymentPipelineStage) error {
+	if expected == "" {
+		return nil
+	}
+	name, known := stage.String("display_name")
+	if !known || strings.EqualFold(name, expected) {
+		return nil
+	}
+	return runner.EmitIssue(
+		r,
+		fmt.Sprintf("The %s stage should be named '%s' (current: '%s')", position, expected, name),
+		stage.Range("display_name"),
+	)
+}
+
+// resolveWorkspace returns the literal workspace ID or the workspace resource instance a stage is assigned to, or ""
+func (r *FabricDeploymentPipelineStagesSemantics) resolveWorkspace(runner tflint.Runner, resolver *referenceResolver, stage *deploymentPipelineStage, forEach hcl.Expression, multiInstance map[string]bool) string {
+	if id, known := stage.String("workspace_id"); known {
+		return strings.ToLower(id)
+	}
+	expr, exists := stage.exprs["workspace_id"]
+	if !exists || expr == nil {
+		return ""
+	}
+
+	// Instances of a counted or for_each workspace are told apart by their index,
+	// e.g. fabric_workspace.env["dev"].id
+	if reference, indexed := instanceReference(runner, expr, nil); indexed {
+		return reference
+	}
+
+	workspace := resolver.ResolveID(runner, expr, forEach)
+	if multiInstance[workspace] {
+		return ""
+	}
+	return workspace
+}
+
+// multiInstanceWorkspaces returns the fabric_workspace resources declared with count or for_each
+func (r *FabricDeploymentPipelineStagesSemantics) multiInstanceWorkspaces(runner tflint.Runner, opts *tflint.GetModuleContentOption) (map[string]bool, error) {
+	workspaces, err := runner.GetResourceContent("fabric_workspace", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "count"},
+			{Name: "for_each"},
+		},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	multiInstance := make(map[string]bool)
+	for _, block := range workspaces.Blocks {
+		if len(block.Body.Attributes) > 0 {
+			multiInstance[strings.Join(block.Labels, ".")] = true
+		}
+	}
+	return multiInstance, nil
+}
@@ -0,0 +1,197 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+)
+
+// FabricDomainHierarchy validates the domain graph built from parent_domain_id references
+// Fabric supports a single level of subdomains, and only root domains can set contributors_scope
+// or receive domain role assignments
+type FabricDomainHierarchy struct {
+	tflint.DefaultRule
+}
+
+// domainNode is a fabric_domain in the domain graph
+type domainNode struct {
+	address    string
+	block      *hclext.Block
+	parentAttr *hclext.Attribute
+	// parent is the fabric_domain referenced by parent_domain_id, or "" for literal IDs and root domains
+	parent      string
+	isSubdomain bool
+}
+
+func NewFabricDomainHierarchy() *FabricDomainHierarchy {
+	return &FabricDomainHierarchy{}
+}
+
+func (r *FabricDomainHierarchy) Name() string {
+	return "fabric_domain_hierarchy"
+}
+
+func (r *FabricDomainHierarchy) Enabled() bool {
+	return true
+}
+
+func (r *FabricDomainHierarchy) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *FabricDomainHierarchy) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricDomainHierarchy) Check(runner tflint.Runner) error {
+	resolver, err := newReferenceResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	// The graph is built from declarations, so count and for_each instances share a node
+	noExpand := &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone}
+
+	domains, err := runner.GetResourceContent("fabric_domain", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "parent_domain_id"},
+			{Name: "contributors_scope"},
+			{Name: "for_each"},
+		},
+	}, noExpand)
+	if err != nil {
+		return err
+	}
+
+	var nodes []*domainNode
+	graph := make(map[string]*domainNode)
+
+	for _, block := range domains.Blocks {
+		node := &domainNode{
+			address: fmt.Sprintf("fabric_domain.%s", block.Labels[1]),
+			block:   block,
+		}
+
+		if attr, exists := block.Body.Attributes["parent_domain_id"]; exists && attr.Expr != nil && !isNullAttribute(runner, attr) {
+			node.parentAttr = attr
+			node.isSubdomain = true
+			node.parent = r.resolveDomain(resolver, attr.Expr, forEachExpr(block))
+		}
+
+		nodes = append(nodes, node)
+		graph[node.address] = node
+	}
+
+	for _, node := range nodes {
+		if !node.isSubdomain {
+			continue
+		}
+
+		if cycle := r.findCycle(graph, node); cycle != nil {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Domain '%s' is part of a parent_domain_id cycle: %s", node.address, strings.Join(cycle, " -> ")),
+				node.parentAttr.Range,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if parent, exists := graph[node.parent]; exists && parent.isSubdomain {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Domain '%s' is nested under subdomain '%s'. Fabric supports only one level of subdomains", node.address, parent.address),
+				node.parentAttr.Range,
+			); err != nil {
+				return err
+			}
+		}
+
+		if attr, exists := node.block.Body.Attributes["contributors_scope"]; exists && attr.Expr != nil && !isNullAttribute(runner, attr) {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Subdomain '%s' cannot set contributors_scope. Only root domains control who can assign workspaces", node.address),
+				attr.Range,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return r.checkRoleAssignments(runner, resolver, graph, noExpand)
+}
+
+// checkRoleAssignments reports fabric_domain_role_assignments that target a subdomain
+func (r *FabricDomainHierarchy) checkRoleAssignments(runner tflint.Runner, resolver *referenceResolver, graph map[string]*domainNode, opts *tflint.GetModuleContentOption) error {
+	roleAssignments, err := runner.GetResourceContent("fabric_domain_role_assignments", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "domain_id"},
+			{Name: "for_each"},
+		},
+	}, opts)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range roleAssignments.Blocks {
+		attr, exists := block.Body.Attributes["domain_id"]
+		if !exists || attr.Expr == nil {
+			continue
+		}
+
+		domain, exists := graph[r.resolveDomain(resolver, attr.Expr, forEachExpr(block))]
+		if !exists || !domain.isSubdomain {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("Domain role assignments are not supported on subdomain '%s'. Assign domain roles on the root domain instead", domain.address),
+			attr.Range,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveDomain returns the single fabric_domain that expr refers to, or ""
+func (r *FabricDomainHierarchy) resolveDomain(resolver *referenceResolver, expr hcl.Expression, forEach hcl.Expression) string {
+	var domain string
+	for _, reference := range resolver.ResourceReferences(expr, forEach) {
+		if !strings.HasPrefix(reference, "fabric_domain.") {
+			continue
+		}
+		if domain != "" {
+			return ""
+		}
+		domain = reference
+	}
+	return domain
+}
+
+// findCycle returns the parent chain starting and ending at node, or nil if node is not part of a cycle
+func (r *FabricDomainHierarchy) findCycle(graph map[string]*domainNode, node *domainNode) []string {
+	path := []string{node.address}
+	visited := map[string]bool{node.address: true}
+
+	for current := graph[node.parent]; current != nil; current = graph[current.parent] {
+		path = append(path, current.address)
+		if current.address == node.address {
+			return path
+		}
+		if visited[current.address] {
+			// A cycle further up the chain that does not include node
+			return nil
+		}
+		visited[current.address] = true
+	}
+	return nil
+}
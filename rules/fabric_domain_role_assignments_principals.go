@@ -0,0 +1,184 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+	"github.com/RuneORakeie/tflint-ruleset-fabric/rules/apispec"
+)
+
+// FabricDomainRoleAssignmentsPrincipals validates the principals list of fabric_domain_role_assignments
+// Each entry needs a UUID id and a supported principal type, and a principal may only be listed once
+type FabricDomainRoleAssignmentsPrincipals struct {
+	tflint.DefaultRule
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// principalTypesRule is the generated rule whose enum lists the principal types domain roles can be granted to,
+// the spec enum filtered to the types schema.json allows
+const principalTypesRule = "fabric_domain_role_assignments_principals_invalid_type"
+
+func NewFabricDomainRoleAssignmentsPrincipals() *FabricDomainRoleAssignmentsPrincipals {
+	return &FabricDomainRoleAssignmentsPrincipals{}
+}
+
+func (r *FabricDomainRoleAssignmentsPrincipals) Name() string {
+	return "fabric_domain_role_assignments_principals"
+}
+
+func (r *FabricDomainRoleAssignmentsPrincipals) Enabled() bool {
+	return true
+}
+
+func (r *FabricDomainRoleAssignmentsPrincipals) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *FabricDomainRoleAssignmentsPrincipals) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricDomainRoleAssignmentsPrincipals) Check(runner tflint.Runner) error {
+	resourceContent, err := runner.GetResourceContent("fabric_domain_role_assignments", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "principals"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	validTypesList := apispec.CatalogEnum(principalTypesRule)
+	validTypes := make(map[string]bool, len(validTypesList))
+	for _, t := range validTypesList {
+		validTypes[t] = true
+	}
+
+	for _, resource := range resourceContent.Blocks {
+		attr, exists := resource.Body.Attributes["principals"]
+		if !exists || attr.Expr == nil {
+			continue
+		}
+
+		var principals cty.Value
+		if err := runner.EvaluateExpr(attr.Expr, &principals, nil); err != nil {
+			continue
+		}
+		if principals.IsNull() || !principals.IsKnown() || !principals.CanIterateElements() {
+			continue
+		}
+
+		// Point at the individual entry when the list is written inline
+		var elementExprs []hclsyntax.Expression
+		if tuple, ok := attr.Expr.(*hclsyntax.TupleConsExpr); ok && len(tuple.Exprs) == principals.LengthInt() {
+			elementExprs = tuple.Exprs
+		}
+
+		seen := make(map[string]int)
+		index := 0
+		for it := principals.ElementIterator(); it.Next(); index++ {
+			_, principal := it.Element()
+
+			issueRange := attr.Range
+			if elementExprs != nil {
+				issueRange = elementExprs[index].Range()
+			}
+
+			for _, message := range r.validatePrincipal(principal, index, validTypes, validTypesList) {
+				if err := runner.EmitIssue(r, message, issueRange); err != nil {
+					return err
+				}
+			}
+
+			id := principalIDFromValue(principal)
+			if id == "" {
+				continue
+			}
+			if first, exists := seen[strings.ToLower(id)]; exists {
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Principal '%s' is listed more than once in principals (entries %d and %d)", id, first, index),
+					issueRange,
+				); err != nil {
+					return err
+				}
+				continue
+			}
+			seen[strings.ToLower(id)] = index
+		}
+	}
+
+	return nil
+}
+
+// validatePrincipal returns a message for each problem with a single principals entry
+func (r *FabricDomainRoleAssignmentsPrincipals) validatePrincipal(principal cty.Value, index int, validTypes map[string]bool, validTypesList []string) []string {
+	if principal.IsNull() || !principal.IsKnown() {
+		return nil
+	}
+	if !principal.Type().IsObjectType() && !principal.Type().IsMapType() {
+		return []string{fmt.Sprintf("principals entry %d must be an object with id and type", index)}
+	}
+
+	var messages []string
+
+	id, idKnown := principalAttribute(principal, "id")
+	switch {
+	case !idKnown:
+		// Unknown until apply, e.g. a group object ID from another provider
+	case id == "":
+		messages = append(messages, fmt.Sprintf("principals entry %d is missing id", index))
+	case !uuidPattern.MatchString(id):
+		messages = append(messages, fmt.Sprintf("principals entry %d has invalid id '%s'. Must be a UUID", index, id))
+	}
+
+	principalType, typeKnown := principalAttribute(principal, "type")
+	switch {
+	case !typeKnown:
+	case principalType == "":
+		messages = append(messages, fmt.Sprintf("principals entry %d is missing type", index))
+	case len(validTypes) > 0 && !validTypes[principalType]:
+		messages = append(messages, fmt.Sprintf("principals entry %d has invalid type '%s'. Must be one of: %s", index, principalType, strings.Join(validTypesList, ", ")))
+	}
+
+	return messages
+}
+
+// principalAttribute returns a string attribute of a principal object, and false when its value is not yet known
+func principalAttribute(principal cty.Value, name string) (string, bool) {
+	var value cty.Value
+	switch {
+	case principal.Type().IsObjectType():
+		if !principal.Type().HasAttribute(name) {
+			return "", true
+		}
+		value = principal.GetAttr(name)
+	case principal.Type().IsMapType():
+		hasIndex := principal.HasIndex(cty.StringVal(name))
+		if !hasIndex.IsKnown() {
+			return "", false
+		}
+		if hasIndex.False() {
+			return "", true
+		}
+		value = principal.Index(cty.StringVal(name))
+	default:
+		return "", true
+	}
+
+	if !value.IsKnown() {
+		return "", false
+	}
+	if value.IsNull() || !value.Type().Equals(cty.String) {
+		return "", true
+	}
+	return value.AsString(), true
+}
@@ -0,0 +1,237 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+)
+
+// FabricDomainWorkspaceAssignmentsConflict reports conflicting fabric_domain_workspace_assignments
+// A workspace belongs to a single domain, and each assignment resource owns the full workspace list of its domain
+type FabricDomainWorkspaceAssignmentsConflict struct {
+	tflint.DefaultRule
+}
+
+// fabricDomainWorkspaceAssignmentsConflictConfig is the rule configuration
+type fabricDomainWorkspaceAssignmentsConflictConfig struct {
+	// ExternalWorkspaceIDs lists workspaces managed outside this configuration that may be assigned by ID
+	ExternalWorkspaceIDs []string `hclext:"external_workspace_ids,optional"`
+}
+
+// assignedWorkspace is a single entry of workspace_ids
+type assignedWorkspace struct {
+	// key is the literal workspace ID (lower case) or the referenced resource instance,
+	// e.g. "fabric_workspace.example" or `fabric_workspace.env["dev"]`
+	key string
+	// resource is the referenced resource without the instance index, "" for literal IDs
+	resource string
+	literal  bool
+	rng      hcl.Range
+}
+
+// domainWorkspaceAssignment remembers the first assignment resource seen for a domain or workspace
+type domainWorkspaceAssignment struct {
+	block  *hclext.Block
+	domain string
+}
+
+func NewFabricDomainWorkspaceAssignmentsConflict() *FabricDomainWorkspaceAssignmentsConflict {
+	return &FabricDomainWorkspaceAssignmentsConflict{}
+}
+
+func (r *FabricDomainWorkspaceAssignmentsConflict) Name() string {
+	return "fabric_domain_workspace_assignments_conflict"
+}
+
+func (r *FabricDomainWorkspaceAssignmentsConflict) Enabled() bool {
+	return true
+}
+
+func (r *FabricDomainWorkspaceAssignmentsConflict) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *FabricDomainWorkspaceAssignmentsConflict) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricDomainWorkspaceAssignmentsConflict) Check(runner tflint.Runner) error {
+	config := fabricDomainWorkspaceAssignmentsConflictConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	externalWorkspaces := make(map[string]bool, len(config.ExternalWorkspaceIDs))
+	for _, id := range config.ExternalWorkspaceIDs {
+		externalWorkspaces[strings.ToLower(id)] = true
+	}
+
+	resolver, err := newReferenceResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	// References are resolved per declaration, so for_each instances are not expanded
+	noExpand := &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone}
+
+	workspaces, err := runner.GetResourceContent("fabric_workspace", &hclext.BodySchema{}, noExpand)
+	if err != nil {
+		return err
+	}
+	declaredWorkspaces := make(map[string]bool, len(workspaces.Blocks))
+	for _, block := range workspaces.Blocks {
+		declaredWorkspaces[fmt.Sprintf("fabric_workspace.%s", block.Labels[1])] = true
+	}
+
+	assignments, err := runner.GetResourceContent("fabric_domain_workspace_assignments", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "domain_id"},
+			{Name: "workspace_ids"},
+			{Name: "for_each"},
+		},
+	}, noExpand)
+	if err != nil {
+		return err
+	}
+
+	domainOwners := make(map[string]domainWorkspaceAssignment)
+	workspaceOwners := make(map[string]domainWorkspaceAssignment)
+
+	for _, block := range assignments.Blocks {
+		forEach := forEachExpr(block)
+
+		// Instances of a domain are told apart by a literal index, e.g. fabric_domain.example["sales"].id,
+		// and a domain indexed with each.key is unknown
+		var domain string
+		if attr, exists := block.Body.Attributes["domain_id"]; exists {
+			domain = resolver.ResolveInstanceID(runner, attr.Expr, forEach, nil)
+		}
+		if domain != "" {
+			if first, exists := domainOwners[domain]; exists {
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Domain '%s' is already targeted by %s (%s). Workspace assignments for the same domain overwrite each other",
+						domain, strings.Join(first.block.Labels, "."), first.block.DefRange.String()),
+					block.Body.Attributes["domain_id"].Range,
+				); err != nil {
+					return err
+				}
+			} else {
+				domainOwners[domain] = domainWorkspaceAssignment{block: block, domain: domain}
+			}
+		}
+
+		attr, exists := block.Body.Attributes["workspace_ids"]
+		if !exists || attr.Expr == nil {
+			continue
+		}
+
+		for _, workspace := range r.resolveWorkspaces(runner, resolver, attr, forEach) {
+			if workspace.literal && !externalWorkspaces[workspace.key] {
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Workspace '%s' is not managed in this configuration. Reference a fabric_workspace resource or add it to external_workspace_ids", workspace.key),
+					workspace.rng,
+				); err != nil {
+					return err
+				}
+			}
+			if !workspace.literal && strings.HasPrefix(workspace.resource, "fabric_workspace.") && !declaredWorkspaces[workspace.resource] {
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Workspace '%s' is not declared in this configuration", workspace.key),
+					workspace.rng,
+				); err != nil {
+					return err
+				}
+			}
+
+			first, exists := workspaceOwners[workspace.key]
+			if !exists {
+				workspaceOwners[workspace.key] = domainWorkspaceAssignment{block: block, domain: domain}
+				continue
+			}
+			// Repeats within one resource collapse in the set, and repeats for the same domain are reported above
+			if first.block == block || (domain != "" && first.domain == domain) {
+				continue
+			}
+
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Workspace '%s' is also assigned to domain '%s' by %s (%s). A workspace can belong to only one domain",
+					workspace.key, first.domain, strings.Join(first.block.Labels, "."), first.block.DefRange.String()),
+				workspace.rng,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveWorkspaces returns the entries of workspace_ids that can be resolved statically
+func (r *FabricDomainWorkspaceAssignmentsConflict) resolveWorkspaces(runner tflint.Runner, resolver *referenceResolver, attr *hclext.Attribute, forEach hcl.Expression) []assignedWorkspace {
+	expr := attr.Expr
+
+	// toset([...]) and tolist([...]) are treated like the inline list
+	if call, ok := expr.(*hclsyntax.FunctionCallExpr); ok && (call.Name == "toset" || call.Name == "tolist") && len(call.Args) == 1 {
+		expr = call.Args[0]
+	}
+
+	if tuple, ok := expr.(*hclsyntax.TupleConsExpr); ok {
+		var workspaces []assignedWorkspace
+		for _, element := range tuple.Exprs {
+			workspaces = append(workspaces, r.resolveWorkspaceExpr(runner, resolver, element, forEach)...)
+		}
+		return workspaces
+	}
+
+	var value cty.Value
+	if err := runner.EvaluateExpr(attr.Expr, &value, nil); err == nil && value.IsWhollyKnown() && !value.IsNull() && value.CanIterateElements() {
+		var workspaces []assignedWorkspace
+		for it := value.ElementIterator(); it.Next(); {
+			_, element := it.Element()
+			if element.IsNull() || !element.Type().Equals(cty.String) {
+				continue
+			}
+			workspaces = append(workspaces, assignedWorkspace{key: strings.ToLower(element.AsString()), literal: true, rng: attr.Range})
+		}
+		return workspaces
+	}
+
+	return r.resolveWorkspaceExpr(runner, resolver, attr.Expr, forEach)
+}
+
+// resolveWorkspaceExpr resolves a single expression to a literal workspace ID or the workspaces it refers to
+func (r *FabricDomainWorkspaceAssignmentsConflict) resolveWorkspaceExpr(runner tflint.Runner, resolver *referenceResolver, expr hcl.Expression, forEach hcl.Expression) []assignedWorkspace {
+	var id string
+	if err := runner.EvaluateExpr(expr, &id, nil); err == nil && id != "" {
+		return []assignedWorkspace{{key: strings.ToLower(id), literal: true, rng: expr.Range()}}
+	}
+
+	// Instances of a workspace are told apart by a literal index, e.g. fabric_workspace.env["dev"].id,
+	// and a workspace indexed with each.key is unknown
+	if reference, indexed := instanceReference(runner, expr, nil); indexed {
+		resource, _, _ := strings.Cut(reference, "[")
+		if reference == "" || !strings.HasPrefix(resource, "fabric_workspace.") {
+			return nil
+		}
+		return []assignedWorkspace{{key: reference, resource: resource, rng: expr.Range()}}
+	}
+
+	var workspaces []assignedWorkspace
+	for _, reference := range resolver.ResourceReferences(expr, forEach) {
+		if strings.HasPrefix(reference, "fabric_workspace.") {
+			workspaces = append(workspaces, assignedWorkspace{key: reference, resource: reference, rng: expr.Range()})
+		}
+	}
+	return workspaces
+}
@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+)
+
+// eventhouseConsumptionUnits are the minimum consumption units the API accepts below 51, as listed in schema.json
+var eventhouseConsumptionUnits = []float64{0, 2.25, 4.25, 8.5, 13, 18, 26, 34, 50}
+
+// Any number of minimum consumption units between these bounds is accepted too
+const (
+	eventhouseConsumptionUnitsRangeMin = 51
+	eventhouseConsumptionUnitsRangeMax = 322
+)
+
+// FabricEventhouseMinimumConsumptionUnits validates configuration.minimum_consumption_units of fabric_eventhouse
+type FabricEventhouseMinimumConsumptionUnits struct {
+	tflint.DefaultRule
+}
+
+func NewFabricEventhouseMinimumConsumptionUnits() *FabricEventhouseMinimumConsumptionUnits {
+	return &FabricEventhouseMinimumConsumptionUnits{}
+}
+
+func (r *FabricEventhouseMinimumConsumptionUnits) Name() string {
+	return "fabric_eventhouse_minimum_consumption_units"
+}
+
+func (r *FabricEventhouseMinimumConsumptionUnits) Enabled() bool {
+	return true
+}
+
+func (r *FabricEventhouseMinimumConsumptionUnits) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *FabricEventhouseMinimumConsumptionUnits) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricEventhouseMinimumConsumptionUnits) Check(runner tflint.Runner) error {
+	resourceContent, err := runner.GetResourceContent("fabric_eventhouse", nestedAttributesSchema("configuration.minimum_consumption_units"), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resourceContent.Blocks {
+		expr := nestedAttributeExpr(resource.Body, "configuration.minimum_consumption_units")
+		if expr == nil {
+			continue
+		}
+		var units cty.Value
+		if err := runner.EvaluateExpr(expr, &units, &tflint.EvaluateExprOption{WantType: &cty.Number}); err != nil || !units.IsKnown() || units.IsNull() {
+			continue
+		}
+		value, _ := units.AsBigFloat().Float64()
+		if validEventhouseConsumptionUnits(value) {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("configuration.minimum_consumption_units %s is invalid. Must be one of %s, or a number between %d and %d",
+				units.AsBigFloat().Text('g', -1), formatEventhouseConsumptionUnits(), eventhouseConsumptionUnitsRangeMin, eventhouseConsumptionUnitsRangeMax),
+			expr.Range(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validEventhouseConsumptionUnits(value float64) bool {
+	if value >= eventhouseConsumptionUnitsRangeMin && value <= eventhouseConsumptionUnitsRangeMax {
+		return true
+	}
+	for _, units := range eventhouseConsumptionUnits {
+		if value == units {
+			return true
+		}
+	}
+	return false
+}
+
+// formatEventhouseConsumptionUnits lists eventhouseConsumptionUnits for messages, e.g. "0, 2.25, 4.25"
+func formatEventhouseConsumptionUnits() string {
+	values := make([]string, len(eventhouseConsumptionUnits))
+	for i, units := range eventhouseConsumptionUnits {
+		values[i] = strconv.FormatFloat(units, 'g', -1, 64)
+	}
+	return strings.Join(values, ", ")
+}
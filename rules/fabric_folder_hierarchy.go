@@ -0,0 +1,194 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+)
+
+// defaultFolderMaxDepth is the deepest folder nesting Fabric allows, counting top-level folders as depth 1
+const defaultFolderMaxDepth = 10
+
+// FabricFolderHierarchy validates the folder tree built from parent_folder_id references
+// It reports nesting beyond the folder depth limit, cycles, parents in another workspace
+// and sibling folders with the same display name
+type FabricFolderHierarchy struct {
+	tflint.DefaultRule
+}
+
+// fabricFolderHierarchyConfig is the rule configuration
+type fabricFolderHierarchyConfig struct {
+	MaxDepth int `hclext:"max_depth,optional"`
+}
+
+// folderNode is a fabric_folder in the folder tree
+type folderNode struct {
+	address    string
+	block      *hclext.Block
+	parentAttr *hclext.Attribute
+	// parent is the literal parent ID or referenced fabric_folder, "" for top-level folders
+	parent    string
+	workspace string
+}
+
+func NewFabricFolderHierarchy() *FabricFolderHierarchy {
+	return &FabricFolderHierarchy{}
+}
+
+func (r *FabricFolderHierarchy) Name() string {
+	return "fabric_folder_hierarchy"
+}
+
+func (r *FabricFolderHierarchy) Enabled() bool {
+	return true
+}
+
+func (r *FabricFolderHierarchy) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *FabricFolderHierarchy) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricFolderHierarchy) Check(runner tflint.Runner) error {
+	config := fabricFolderHierarchyConfig{MaxDepth: defaultFolderMaxDepth}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resolver, err := newReferenceResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	// The tree is built from declarations, so count and for_each instances share a node
+	folders, err := runner.GetResourceContent("fabric_folder", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "display_name"},
+			{Name: "workspace_id"},
+			{Name: "parent_folder_id"},
+			{Name: "for_each"},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	var nodes []*folderNode
+	tree := make(map[string]*folderNode)
+
+	for _, block := range folders.Blocks {
+		forEach := forEachExpr(block)
+		node := &folderNode{
+			address: fmt.Sprintf("fabric_folder.%s", block.Labels[1]),
+			block:   block,
+		}
+		if attr, exists := block.Body.Attributes["workspace_id"]; exists {
+			node.workspace = resolver.ResolveID(runner, attr.Expr, forEach)
+		}
+		if attr, exists := block.Body.Attributes["parent_folder_id"]; exists && attr.Expr != nil {
+			node.parentAttr = attr
+			node.parent = resolver.ResolveID(runner, attr.Expr, forEach)
+		}
+
+		nodes = append(nodes, node)
+		tree[node.address] = node
+	}
+
+	siblings := make(map[string]*folderNode)
+
+	for _, node := range nodes {
+		if err := r.checkParent(runner, tree, node, config.MaxDepth); err != nil {
+			return err
+		}
+
+		attr, exists := node.block.Body.Attributes["display_name"]
+		if !exists || attr.Expr == nil || node.workspace == "" {
+			continue
+		}
+		// A parent that can't be resolved would put the folder among the workspace root folders
+		if node.parentAttr != nil && node.parent == "" {
+			continue
+		}
+		var displayName string
+		if err := runner.EvaluateExpr(attr.Expr, &displayName, nil); err != nil || displayName == "" {
+			continue
+		}
+
+		// Folder names are unique among siblings, ignoring case
+		key := fmt.Sprintf("%s|%s|%s", node.workspace, node.parent, strings.ToLower(displayName))
+		first, exists := siblings[key]
+		if !exists {
+			siblings[key] = node
+			continue
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("Folder '%s' has the same display name as %s (%s) under the same parent. Sibling folder names must be unique",
+				displayName, first.address, first.block.DefRange.String()),
+			attr.Range,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkParent reports cycles, cross-workspace parents and nesting beyond maxDepth for a single folder
+func (r *FabricFolderHierarchy) checkParent(runner tflint.Runner, tree map[string]*folderNode, node *folderNode, maxDepth int) error {
+	parent, exists := tree[node.parent]
+	if !exists {
+		return nil
+	}
+
+	if parent.workspace != "" && node.workspace != "" && parent.workspace != node.workspace {
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("Folder '%s' is in workspace '%s', but its parent '%s' is in workspace '%s'. Parent folders must be in the same workspace",
+				node.address, node.workspace, parent.address, parent.workspace),
+			node.parentAttr.Range,
+		); err != nil {
+			return err
+		}
+	}
+
+	// Walk up the tree to detect cycles and measure depth
+	path := []string{node.address}
+	visited := map[string]bool{node.address: true}
+	for current := parent; current != nil; current = tree[current.parent] {
+		path = append(path, current.address)
+		if current.address == node.address {
+			return runner.EmitIssue(
+				r,
+				fmt.Sprintf("Folder '%s' is part of a parent_folder_id cycle: %s", node.address, strings.Join(path, " -> ")),
+				node.parentAttr.Range,
+			)
+		}
+		if visited[current.address] {
+			// A cycle further up the tree is reported on its own members
+			return nil
+		}
+		visited[current.address] = true
+	}
+
+	depth := len(path)
+	if top := tree[path[len(path)-1]]; top.parent != "" {
+		// The chain continues in a folder that is not managed here
+		depth++
+	}
+	if depth > maxDepth {
+		return runner.EmitIssue(
+			r,
+			fmt.Sprintf("Folder '%s' is nested %d levels deep. Fabric allows at most %d levels of folders", node.address, depth, maxDepth),
+			node.parentAttr.Range,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+)
+
+// gatewayTypeAttributes are the attributes each gateway type requires and forbids.
+// Only virtual network gateways can be created, other types are reported by fabric_gateway_invalid_type
+var gatewayTypeAttributes = map[string]struct {
+	required  []string
+	forbidden []string
+}{
+	"VirtualNetwork": {
+		required:  []string{"display_name", "capacity_id", "inactivity_minutes_before_sleep", "number_of_member_gateways", "virtual_network_azure_resource"},
+		forbidden: []string{"load_balancing_setting", "public_key", "allow_custom_connectors", "allow_cloud_connection_refresh"},
+	},
+}
+
+// virtualNetworkAzureResourceFields are the fields of virtual_network_azure_resource with their naming rules
+var virtualNetworkAzureResourceFields = []struct {
+	name        string
+	pattern     *regexp.Regexp
+	description string
+}{
+	{
+		name:        "subscription_id",
+		pattern:     regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`),
+		description: "a GUID",
+	},
+	{
+		name:        "resource_group_name",
+		pattern:     regexp.MustCompile(`^[\p{L}\p{N}_\-.()]{0,89}[\p{L}\p{N}_\-()]$`),
+		description: "1-90 letters, digits, underscores, hyphens, periods and parentheses, not ending with a period",
+	},
+	{
+		name:        "virtual_network_name",
+		pattern:     regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,62}[a-zA-Z0-9_]$`),
+		description: "2-64 letters, digits, underscores, hyphens and periods, starting with a letter or digit and ending with a letter, digit or underscore",
+	},
+	{
+		name:        "subnet_name",
+		pattern:     regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_])?$`),
+		description: "1-80 letters, digits, underscores, hyphens and periods, starting with a letter or digit and ending with a letter, digit or underscore",
+	},
+}
+
+// FabricGatewayTypeAttributes validates the attributes of fabric_gateway against its type
+// Virtual network gateways require their own attributes and cannot set the ones of on-premises gateways
+type FabricGatewayTypeAttributes struct {
+	tflint.DefaultRule
+}
+
+func NewFabricGatewayTypeAttributes() *FabricGatewayTypeAttributes {
+	return &FabricGatewayTypeAttributes{}
+}
+
+func (r *FabricGatewayTypeAttributes) Name() string {
+	return "fabric_gateway_type_attributes"
+}
+
+func (r *FabricGatewayTypeAttributes) Enabled() bool {
+	return true
+}
+
+func (r *FabricGatewayTypeAttributes) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *FabricGatewayTypeAttributes) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricGatewayTypeAttributes) Check(runner tflint.Runner) error {
+	paths := []string{
+		"type",
+		"display_name",
+		"capacity_id",
+		"inactivity_minutes_before_sleep",
+		"number_of_member_gateways",
+		"load_balancing_setting",
+		"public_key.exponent",
+		"public_key.modulus",
+		"allow_custom_connectors",
+		"allow_cloud_connection_refresh",
+	}
+	for _, field := range virtualNetworkAzureResourceFields {
+		paths = append(paths, "virtual_network_azure_resource."+field.name)
+	}
+
+	resourceContent, err := runner.GetResourceContent("fabric_gateway", nestedAttributesSchema(paths...), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resourceContent.Blocks {
+		if err := r.checkVirtualNetworkAzureResource(runner, resource.Body); err != nil {
+			return err
+		}
+
+		typeAttr, exists := resource.Body.Attributes["type"]
+		if !exists || typeAttr.Expr == nil {
+			continue
+		}
+		var gatewayType string
+		if err := runner.EvaluateExpr(typeAttr.Expr, &gatewayType, nil); err != nil {
+			continue
+		}
+		attributes, known := gatewayTypeAttributes[gatewayType]
+		if !known {
+			continue
+		}
+
+		for _, name := range attributes.required {
+			if _, set := r.attributeRange(runner, resource.Body, name); set {
+				continue
+			}
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s is required when type is '%s'", name, gatewayType),
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+		}
+
+		for _, name := range attributes.forbidden {
+			rng, set := r.attributeRange(runner, resource.Body, name)
+			if !set {
+				continue
+			}
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s cannot be set when type is '%s'", name, gatewayType),
+				rng,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// attributeRange returns the range of a top-level attribute or block, and false when it is missing or null
+func (r *FabricGatewayTypeAttributes) attributeRange(runner tflint.Runner, body *hclext.BodyContent, name string) (hcl.Range, bool) {
+	if attr, exists := body.Attributes[name]; exists {
+		if attr.Expr == nil || isNullAttribute(runner, attr) {
+			return hcl.Range{}, false
+		}
+		return attr.Range, true
+	}
+	if blocks := body.Blocks.OfType(name); len(blocks) > 0 {
+		return blocks[0].DefRange, true
+	}
+	return hcl.Range{}, false
+}
+
+// checkVirtualNetworkAzureResource validates the fields of virtual_network_azure_resource written as an object or a block
+func (r *FabricGatewayTypeAttributes) checkVirtualNetworkAzureResource(runner tflint.Runner, body *hclext.BodyContent) error {
+	var objectRange hcl.Range
+	if attr, exists := body.Attributes["virtual_network_azure_resource"]; exists {
+		// An object that is not written out, e.g. virtual_network_azure_resource = var.vnet, has no fields to check
+		if _, literal := attr.Expr.(*hclsyntax.ObjectConsExpr); !literal {
+			return nil
+		}
+		objectRange = attr.Range
+	} else if blocks := body.Blocks.OfType("virtual_network_azure_resource"); len(blocks) > 0 {
+		objectRange = blocks[0].DefRange
+	} else {
+		return nil
+	}
+
+	for _, field := range virtualNetworkAzureResourceFields {
+		expr := nestedAttributeExpr(body, "virtual_network_azure_resource."+field.name)
+		if expr == nil {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("virtual_network_azure_resource.%s is required", field.name),
+				objectRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var value string
+		if err := runner.EvaluateExpr(expr, &value, nil); err != nil {
+			continue
+		}
+		if field.pattern.MatchString(value) {
+			continue
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("virtual_network_azure_resource.%s '%s' is invalid. It must be %s", field.name, value, field.description),
+			expr.Range(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
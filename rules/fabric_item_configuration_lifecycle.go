@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+)
+
+// FabricItemConfigurationLifecycle warns when create-only item configuration is not protected by a lifecycle block
+// Changing the configuration of these items replaces them, which deletes their data
+type FabricItemConfigurationLifecycle struct {
+	tflint.DefaultRule
+}
+
+func NewFabricItemConfigurationLifecycle() *FabricItemConfigurationLifecycle {
+	return &FabricItemConfigurationLifecycle{}
+}
+
+func (r *FabricItemConfigurationLifecycle) Name() string {
+	return "fabric_item_configuration_lifecycle"
+}
+
+func (r *FabricItemConfigurationLifecycle) Enabled() bool {
+	return true
+}
+
+func (r *FabricItemConfigurationLifecycle) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *FabricItemConfigurationLifecycle) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricItemConfigurationLifecycle) Check(runner tflint.Runner) error {
+	// Items whose configuration can only be set at creation and that hold data
+	resourceTypes := []string{
+		"fabric_lakehouse",
+		"fabric_warehouse",
+		"fabric_warehouse_snapshot",
+	}
+
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "configuration"},
+		},
+		Blocks: []hclext.BlockSchema{
+			{Type: "configuration", Body: &hclext.BodySchema{}},
+			{
+				Type: "lifecycle",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "prevent_destroy"},
+						{Name: "ignore_changes"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, resourceType := range resourceTypes {
+		resourceContent, err := runner.GetResourceContent(resourceType, schema, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resourceContent.Blocks {
+			if !hasNestedObject(resource.Body, "configuration") {
+				continue
+			}
+			if attr, exists := resource.Body.Attributes["configuration"]; exists && isNullAttribute(runner, attr) {
+				continue
+			}
+			if r.protected(runner, resource.Body) {
+				continue
+			}
+
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Changing the configuration of '%s' replaces it and deletes its data. Add lifecycle { prevent_destroy = true } or lifecycle { ignore_changes = [configuration] }",
+					strings.Join(resource.Labels, ".")),
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// protected reports whether the lifecycle block sets prevent_destroy = true, or ignores changes to the configuration
+func (r *FabricItemConfigurationLifecycle) protected(runner tflint.Runner, body *hclext.BodyContent) bool {
+	for _, lifecycle := range body.Blocks.OfType("lifecycle") {
+		if attr, exists := lifecycle.Body.Attributes["prevent_destroy"]; exists {
+			var preventDestroy bool
+			if err := runner.EvaluateExpr(attr.Expr, &preventDestroy, nil); err == nil && preventDestroy {
+				return true
+			}
+		}
+
+		attr, exists := lifecycle.Body.Attributes["ignore_changes"]
+		if !exists {
+			continue
+		}
+		if hcl.ExprAsKeyword(attr.Expr) == "all" {
+			return true
+		}
+		exprs, diags := hcl.ExprList(attr.Expr)
+		if diags.HasErrors() {
+			continue
+		}
+		for _, expr := range exprs {
+			// Both configuration and configuration.enable_schemas protect the create-only settings
+			traversal, diags := hcl.RelTraversalForExpr(expr)
+			if !diags.HasErrors() && len(traversal) > 0 {
+				if name, ok := traversal[0].(hcl.TraverseAttr); ok && name.Name == "configuration" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
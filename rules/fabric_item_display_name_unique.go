@@ -0,0 +1,146 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+)
+
+// FabricItemDisplayNameUnique reports items of the same type with the same display name in one workspace
+// Fabric compares item names case-insensitively, and some item types share a name space
+type FabricItemDisplayNameUnique struct {
+	tflint.DefaultRule
+}
+
+func NewFabricItemDisplayNameUnique() *FabricItemDisplayNameUnique {
+	return &FabricItemDisplayNameUnique{}
+}
+
+func (r *FabricItemDisplayNameUnique) Name() string {
+	return "fabric_item_display_name_unique"
+}
+
+func (r *FabricItemDisplayNameUnique) Enabled() bool {
+	return true
+}
+
+func (r *FabricItemDisplayNameUnique) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *FabricItemDisplayNameUnique) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricItemDisplayNameUnique) Check(runner tflint.Runner) error {
+	// Item resources that live in a workspace, mapped to the name space their display name must be unique in.
+	// Lakehouses get a SQL analytics endpoint with the lakehouse name, which collides with warehouse names.
+	itemNameSpaces := map[string]string{
+		"fabric_activator":            "fabric_activator",
+		"fabric_apache_airflow_job":   "fabric_apache_airflow_job",
+		"fabric_copy_job":             "fabric_copy_job",
+		"fabric_data_pipeline":        "fabric_data_pipeline",
+		"fabric_dataflow":             "fabric_dataflow",
+		"fabric_digital_twin_builder": "fabric_digital_twin_builder",
+		"fabric_environment":          "fabric_environment",
+		"fabric_eventhouse":           "fabric_eventhouse",
+		"fabric_eventstream":          "fabric_eventstream",
+		"fabric_graphql_api":          "fabric_graphql_api",
+		"fabric_kql_dashboard":        "fabric_kql_dashboard",
+		"fabric_kql_database":         "fabric_kql_database",
+		"fabric_kql_queryset":         "fabric_kql_queryset",
+		"fabric_lakehouse":            "sql_endpoint",
+		"fabric_mirrored_database":    "fabric_mirrored_database",
+		"fabric_ml_experiment":        "fabric_ml_experiment",
+		"fabric_ml_model":             "fabric_ml_model",
+		"fabric_mounted_data_factory": "fabric_mounted_data_factory",
+		"fabric_notebook":             "fabric_notebook",
+		"fabric_report":               "fabric_report",
+		"fabric_semantic_model":       "fabric_semantic_model",
+		"fabric_spark_job_definition": "fabric_spark_job_definition",
+		"fabric_sql_database":         "fabric_sql_database",
+		"fabric_variable_library":     "fabric_variable_library",
+		"fabric_warehouse":            "sql_endpoint",
+		"fabric_warehouse_snapshot":   "fabric_warehouse_snapshot",
+	}
+
+	resourceTypes := make([]string, 0, len(itemNameSpaces))
+	for resourceType := range itemNameSpaces {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	// Keep the order of reported issues stable
+	sort.Strings(resourceTypes)
+
+	resolver, err := newReferenceResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]blockInstance)
+
+	for _, resourceType := range resourceTypes {
+		// Blocks are not expanded, so the test runner sees count and for_each instances too.
+		// blockInstances expands them, and display names built from each.key or count.index are compared per instance
+		resourceContent, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{
+				{Name: "display_name"},
+				{Name: "workspace_id"},
+				{Name: "for_each"},
+				{Name: "count"},
+			},
+		}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resourceContent.Blocks {
+			workspaceAttr, exists := resource.Body.Attributes["workspace_id"]
+			if !exists {
+				continue
+			}
+			attr, exists := resource.Body.Attributes["display_name"]
+			if !exists || attr.Expr == nil {
+				continue
+			}
+
+			for _, instance := range blockInstances(runner, resource) {
+				// Instances of a counted or for_each workspace are told apart by their index,
+				// e.g. fabric_workspace.env[each.key].id
+				workspace := resolver.ResolveInstanceID(runner, workspaceAttr.Expr, forEachExpr(resource), instance.ctx)
+				if workspace == "" {
+					continue
+				}
+
+				value, ok := evaluateInstanceExpr(runner, attr.Expr, instance.ctx)
+				if !ok || value.Type() != cty.String || value.AsString() == "" {
+					continue
+				}
+				displayName := value.AsString()
+
+				key := fmt.Sprintf("%s|%s|%s", workspace, itemNameSpaces[resourceType], strings.ToLower(displayName))
+				first, exists := seen[key]
+				if !exists {
+					seen[key] = instance
+					continue
+				}
+
+				if err := runner.EmitIssue(
+					r,
+					fmt.Sprintf("Display name '%s' is already used by %s (%s) in workspace '%s'. Item names must be unique per workspace and type, ignoring case",
+						displayName, first.address, first.block.DefRange.String(), workspace),
+					attr.Range,
+				); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+)
+
+// FabricItemWorkspaceCapacity reports items created in a fabric_workspace without a capacity
+// Fabric items can only be created in workspaces assigned to a capacity, so the apply fails
+type FabricItemWorkspaceCapacity struct {
+	tflint.DefaultRule
+}
+
+func NewFabricItemWorkspaceCapacity() *FabricItemWorkspaceCapacity {
+	return &FabricItemWorkspaceCapacity{}
+}
+
+func (r *FabricItemWorkspaceCapacity) Name() string {
+	return "fabric_item_workspace_capacity_required"
+}
+
+func (r *FabricItemWorkspaceCapacity) Enabled() bool {
+	return true
+}
+
+func (r *FabricItemWorkspaceCapacity) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *FabricItemWorkspaceCapacity) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricItemWorkspaceCapacity) Check(runner tflint.Runner) error {
+	// Item resources that need a capacity. Reports and semantic models can live in Pro workspaces.
+	resourceTypes := []string{
+		"fabric_activator",
+		"fabric_apache_airflow_job",
+		"fabric_copy_job",
+		"fabric_data_pipeline",
+		"fabric_dataflow",
+		"fabric_digital_twin_builder",
+		"fabric_environment",
+		"fabric_eventhouse",
+		"fabric_eventstream",
+		"fabric_graphql_api",
+		"fabric_kql_dashboard",
+		"fabric_kql_database",
+		"fabric_kql_queryset",
+		"fabric_lakehouse",
+		"fabric_mirrored_database",
+		"fabric_ml_experiment",
+		"fabric_ml_model",
+		"fabric_mounted_data_factory",
+		"fabric_notebook",
+		"fabric_spark_job_definition",
+		"fabric_sql_database",
+		"fabric_variable_library",
+		"fabric_warehouse",
+		"fabric_warehouse_snapshot",
+	}
+
+	resolver, err := newReferenceResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	// Capacity is checked per declaration, so count and for_each instances share a result
+	noExpand := &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone}
+
+	workspaceContent, err := runner.GetResourceContent("fabric_workspace", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "capacity_id"},
+		},
+	}, noExpand)
+	if err != nil {
+		return err
+	}
+
+	// Workspaces declared in this module without capacity_id, or with capacity_id = null
+	withoutCapacity := make(map[string]bool)
+	for _, block := range workspaceContent.Blocks {
+		address := fmt.Sprintf("fabric_workspace.%s", block.Labels[1])
+		attr, exists := block.Body.Attributes["capacity_id"]
+		withoutCapacity[address] = !exists || attr.Expr == nil || isNullAttribute(runner, attr)
+	}
+
+	for _, resourceType := range resourceTypes {
+		resourceContent, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{
+				{Name: "workspace_id"},
+				{Name: "for_each"},
+			},
+		}, noExpand)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resourceContent.Blocks {
+			attr, exists := resource.Body.Attributes["workspace_id"]
+			if !exists || attr.Expr == nil {
+				continue
+			}
+
+			address := strings.Join(resource.Labels, ".")
+			workspaces, ok := resolver.WorkspaceReferences(attr.Expr, forEachExpr(resource))
+			if !ok {
+				// Data sources and module outputs may point at any workspace
+				logger.Debug("workspace of item is not known statically", "item", address)
+				continue
+			}
+
+			var targets []string
+			for _, workspace := range workspaces {
+				if !withoutCapacity[workspace] {
+					targets = nil
+					break
+				}
+				targets = append(targets, workspace)
+			}
+			if len(targets) == 0 {
+				continue
+			}
+
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("Item '%s' is created in workspace '%s', which has no capacity_id. Fabric items can only be created in workspaces assigned to a capacity",
+					address, strings.Join(targets, "' or '")),
+				attr.Range,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
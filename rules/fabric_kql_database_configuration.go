@@ -0,0 +1,240 @@
+package rules
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+)
+
+// kqlDatabaseShortcutAttributes are the configuration attributes only allowed for Shortcut databases
+var kqlDatabaseShortcutAttributes = []string{
+	"invitation_token",
+	"invitation_token_wo",
+	"invitation_token_wo_version",
+	"source_cluster_uri",
+	"source_database_name",
+}
+
+// FabricKQLDatabaseConfiguration validates the creation configuration of fabric_kql_database
+// ReadWrite and Shortcut databases take different attributes, and the parent eventhouse must be in the same workspace
+type FabricKQLDatabaseConfiguration struct {
+	tflint.DefaultRule
+}
+
+func NewFabricKQLDatabaseConfiguration() *FabricKQLDatabaseConfiguration {
+	return &FabricKQLDatabaseConfiguration{}
+}
+
+func (r *FabricKQLDatabaseConfiguration) Name() string {
+	return "fabric_kql_database_configuration"
+}
+
+func (r *FabricKQLDatabaseConfiguration) Enabled() bool {
+	return true
+}
+
+func (r *FabricKQLDatabaseConfiguration) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *FabricKQLDatabaseConfiguration) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricKQLDatabaseConfiguration) Check(runner tflint.Runner) error {
+	paths := []string{"workspace_id", "for_each", "configuration.database_type", "configuration.eventhouse_id"}
+	for _, name := range kqlDatabaseShortcutAttributes {
+		paths = append(paths, "configuration."+name)
+	}
+	schema := nestedAttributesSchema(paths...)
+
+	// Blocks are expanded, so configurations built from each.value or count.index are checked per instance
+	resourceContent, err := runner.GetResourceContent("fabric_kql_database", schema, nil)
+	if err != nil {
+		return err
+	}
+	for _, resource := range resourceContent.Blocks {
+		if err := r.checkDatabaseType(runner, resource); err != nil {
+			return err
+		}
+		if err := r.checkSourceClusterURI(runner, resource.Body); err != nil {
+			return err
+		}
+	}
+
+	return r.checkEventhouses(runner, schema)
+}
+
+// checkDatabaseType reports attributes that are missing or not allowed for the database type
+func (r *FabricKQLDatabaseConfiguration) checkDatabaseType(runner tflint.Runner, resource *hclext.Block) error {
+	typeExpr := nestedAttributeExpr(resource.Body, "configuration.database_type")
+	if typeExpr == nil {
+		return nil
+	}
+	var databaseType string
+	if err := runner.EvaluateExpr(typeExpr, &databaseType, nil); err != nil {
+		return nil
+	}
+
+	set := make(map[string]hcl.Expression)
+	for _, name := range kqlDatabaseShortcutAttributes {
+		if expr := nestedAttributeExpr(resource.Body, "configuration."+name); expr != nil && !isNullExpr(runner, expr) {
+			set[name] = expr
+		}
+	}
+
+	switch databaseType {
+	case "ReadWrite":
+		for _, name := range kqlDatabaseShortcutAttributes {
+			expr, exists := set[name]
+			if !exists {
+				continue
+			}
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("configuration.%s is only allowed when database_type is 'Shortcut'", name),
+				expr.Range(),
+			); err != nil {
+				return err
+			}
+		}
+
+	case "Shortcut":
+		_, hasToken := set["invitation_token"]
+		_, hasWriteOnlyToken := set["invitation_token_wo"]
+		_, hasURI := set["source_cluster_uri"]
+		_, hasDatabase := set["source_database_name"]
+
+		if hasToken && hasWriteOnlyToken {
+			if err := runner.EmitIssue(
+				r,
+				"configuration.invitation_token and configuration.invitation_token_wo cannot both be set",
+				set["invitation_token_wo"].Range(),
+			); err != nil {
+				return err
+			}
+		}
+		if expr, exists := set["invitation_token_wo_version"]; exists && !hasWriteOnlyToken {
+			if err := runner.EmitIssue(
+				r,
+				"configuration.invitation_token_wo_version requires configuration.invitation_token_wo",
+				expr.Range(),
+			); err != nil {
+				return err
+			}
+		}
+
+		if hasToken || hasWriteOnlyToken || (hasURI && hasDatabase) {
+			return nil
+		}
+		message := "A Shortcut database follows a source database. Set configuration.invitation_token, or configuration.source_cluster_uri and configuration.source_database_name"
+		switch {
+		case hasURI:
+			message = "configuration.source_database_name is required with configuration.source_cluster_uri when database_type is 'Shortcut'"
+		case hasDatabase:
+			message = "configuration.source_cluster_uri is required with configuration.source_database_name when database_type is 'Shortcut'"
+		}
+		return runner.EmitIssue(r, message, typeExpr.Range())
+	}
+
+	return nil
+}
+
+// checkSourceClusterURI reports a source cluster URI that is not an https URL of a cluster
+func (r *FabricKQLDatabaseConfiguration) checkSourceClusterURI(runner tflint.Runner, body *hclext.BodyContent) error {
+	expr := nestedAttributeExpr(body, "configuration.source_cluster_uri")
+	if expr == nil {
+		return nil
+	}
+	var uri string
+	if err := runner.EvaluateExpr(expr, &uri, nil); err != nil || uri == "" {
+		return nil
+	}
+
+	parsed, err := url.Parse(uri)
+	if err == nil && parsed.Scheme == "https" && strings.Contains(parsed.Hostname(), ".") &&
+		parsed.User == nil && (parsed.Path == "" || parsed.Path == "/") && parsed.RawQuery == "" && parsed.Fragment == "" {
+		return nil
+	}
+
+	return runner.EmitIssue(
+		r,
+		fmt.Sprintf("configuration.source_cluster_uri '%s' is not a valid cluster URI. Expected https://<cluster>.<region>.kusto.windows.net or the query URI of an eventhouse, e.g. https://<id>.<zone>.kusto.fabric.microsoft.com", uri),
+		expr.Range(),
+	)
+}
+
+// checkEventhouses reports eventhouse_id values that don't refer to an eventhouse in the workspace of the database
+func (r *FabricKQLDatabaseConfiguration) checkEventhouses(runner tflint.Runner, schema *hclext.BodySchema) error {
+	resolver, err := newReferenceResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	// References are checked per declaration, so count and for_each instances share a result
+	noExpand := &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone}
+
+	eventhouseWorkspaces, err := parentItemWorkspaces(runner, resolver, "fabric_eventhouse")
+	if err != nil {
+		return err
+	}
+
+	resourceContent, err := runner.GetResourceContent("fabric_kql_database", schema, noExpand)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resourceContent.Blocks {
+		eventhouseExpr := nestedAttributeExpr(resource.Body, "configuration.eventhouse_id")
+		if eventhouseExpr == nil {
+			continue
+		}
+		address := strings.Join(resource.Labels, ".")
+
+		// A direct reference to another item, e.g. fabric_lakehouse.example.id
+		if reference := extractResourceReference(eventhouseExpr); strings.HasPrefix(reference, "fabric_") && !strings.HasPrefix(reference, "fabric_eventhouse.") {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("configuration.eventhouse_id refers to '%s', which is not an eventhouse", reference),
+				eventhouseExpr.Range(),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		workspaceAttr, exists := resource.Body.Attributes["workspace_id"]
+		if !exists || workspaceAttr.Expr == nil {
+			continue
+		}
+		forEach := forEachExpr(resource)
+		databaseWorkspaces, ok := resolver.WorkspaceReferences(workspaceAttr.Expr, forEach)
+		if !ok {
+			// Data sources, module outputs and fabric_eventhouse.example.workspace_id are not compared
+			logger.Debug("workspace of KQL database is not known statically", "resource", address)
+			continue
+		}
+		others := parentsInOtherWorkspaces(resolver, "fabric_eventhouse", eventhouseExpr, forEach, databaseWorkspaces, eventhouseWorkspaces)
+		if len(others) == 0 {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("KQL database '%s' is created in '%s', but its eventhouse %s is in another workspace. The parent eventhouse must be in the same workspace",
+				address, strings.Join(databaseWorkspaces, "' or '"), strings.Join(others, " or ")),
+			eventhouseExpr.Range(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+)
+
+// FabricRoleAssignmentDuplicate reports role assignments that grant the same principal on the same target twice
+// The Fabric API allows only one role per principal on a workspace, deployment pipeline, domain or gateway,
+// so duplicates always fail at apply time and conflicting roles depend on apply order.
+type FabricRoleAssignmentDuplicate struct {
+	tflint.DefaultRule
+}
+
+// roleAssignmentConfig describes where a role assignment resource keeps its target and principals
+type roleAssignmentConfig struct {
+	roleAssignmentType string
+	referenceAttribute string
+	principalAttribute string
+	targetFriendly     string
+}
+
+// roleAssignmentInstance is one instance of a role assignment block, and the first assignment seen for a target/principal pair
+type roleAssignmentInstance struct {
+	blockInstance
+	role string
+}
+
+func NewFabricRoleAssignmentDuplicate() *FabricRoleAssignmentDuplicate {
+	return &FabricRoleAssignmentDuplicate{}
+}
+
+func (r *FabricRoleAssignmentDuplicate) Name() string {
+	return "fabric_role_assignment_duplicate"
+}
+
+func (r *FabricRoleAssignmentDuplicate) Enabled() bool {
+	return true
+}
+
+func (r *FabricRoleAssignmentDuplicate) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *FabricRoleAssignmentDuplicate) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricRoleAssignmentDuplicate) Check(runner tflint.Runner) error {
+	configs := []roleAssignmentConfig{
+		{
+			roleAssignmentType: "fabric_workspace_role_assignment",
+			referenceAttribute: "workspace_id",
+			principalAttribute: "principal",
+			targetFriendly:     "workspace",
+		},
+		{
+			roleAssignmentType: "fabric_deployment_pipeline_role_assignment",
+			referenceAttribute: "deployment_pipeline_id",
+			principalAttribute: "principal",
+			targetFriendly:     "deployment pipeline",
+		},
+		{
+			roleAssignmentType: "fabric_domain_role_assignments",
+			referenceAttribute: "domain_id",
+			principalAttribute: "principals",
+			targetFriendly:     "domain",
+		},
+		{
+			roleAssignmentType: "fabric_gateway_role_assignment",
+			referenceAttribute: "gateway_id",
+			principalAttribute: "principal",
+			targetFriendly:     "gateway",
+		},
+	}
+
+	resolver, err := newReferenceResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, config := range configs {
+		if err := r.checkRoleAssignments(runner, resolver, config); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *FabricRoleAssignmentDuplicate) checkRoleAssignments(runner tflint.Runner, resolver *referenceResolver, config roleAssignmentConfig) error {
+	// Blocks are not expanded, so the test runner sees count and for_each instances too.
+	// Instances are expanded by blockInstances instead
+	resourceContent, err := runner.GetResourceContent(config.roleAssignmentType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: config.referenceAttribute},
+			{Name: config.principalAttribute},
+			{Name: "role"},
+			{Name: "for_each"},
+			{Name: "count"},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]roleAssignmentInstance)
+
+	for _, resource := range resourceContent.Blocks {
+		for _, block := range blockInstances(runner, resource) {
+			instance := roleAssignmentInstance{blockInstance: block}
+
+			// Instances of a counted or for_each target are told apart by their index,
+			// e.g. fabric_workspace.env["dev"].id or fabric_workspace.env[count.index].id
+			var target string
+			if attr, exists := resource.Body.Attributes[config.referenceAttribute]; exists {
+				target = resolver.ResolveInstanceID(runner, attr.Expr, forEachExpr(resource), instance.ctx)
+			}
+			if target == "" {
+				continue
+			}
+
+			if attr, exists := resource.Body.Attributes["role"]; exists {
+				if value, ok := evaluateInstanceExpr(runner, attr.Expr, instance.ctx); ok && value.Type() == cty.String {
+					instance.role = value.AsString()
+				}
+			}
+
+			for _, principalID := range r.resolvePrincipalIDs(runner, resource.Body.Attributes[config.principalAttribute], instance.ctx) {
+				key := fmt.Sprintf("%s|%s", target, principalID)
+
+				first, exists := seen[key]
+				if !exists {
+					seen[key] = instance
+					continue
+				}
+
+				var message string
+				if instance.role != "" && first.role != "" && instance.role != first.role {
+					message = fmt.Sprintf("Principal '%s' is assigned role '%s' on %s '%s', but %s (%s) assigns role '%s'. A principal can only hold one role per %s.",
+						principalID, instance.role, config.targetFriendly, target, first.address, first.block.DefRange.String(), first.role, config.targetFriendly)
+				} else {
+					message = fmt.Sprintf("Principal '%s' is already assigned to %s '%s' by %s (%s). Duplicate role assignments fail at apply time.",
+						principalID, config.targetFriendly, target, first.address, first.block.DefRange.String())
+				}
+
+				if err := runner.EmitIssue(r, message, resource.DefRange); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolvePrincipalIDs returns the known principal IDs of a single principal object or a set of principals
+func (r *FabricRoleAssignmentDuplicate) resolvePrincipalIDs(runner tflint.Runner, attr *hclext.Attribute, ctx *hcl.EvalContext) []string {
+	if attr == nil || attr.Expr == nil {
+		return nil
+	}
+
+	value, ok := evaluateInstanceExpr(runner, attr.Expr, ctx)
+	if !ok {
+		return nil
+	}
+
+	if value.Type().IsObjectType() || value.Type().IsMapType() {
+		if id := principalIDFromValue(value); id != "" {
+			return []string{id}
+		}
+		return nil
+	}
+
+	if !value.CanIterateElements() {
+		return nil
+	}
+
+	var ids []string
+	for it := value.ElementIterator(); it.Next(); {
+		_, element := it.Element()
+		if id := principalIDFromValue(element); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// principalIDFromValue extracts the "id" of a principal object when it is known
+func principalIDFromValue(value cty.Value) string {
+	if value.IsNull() || !value.IsKnown() {
+		return ""
+	}
+
+	id, known := principalAttribute(value, "id")
+	if !known {
+		return ""
+	}
+	return id
+}
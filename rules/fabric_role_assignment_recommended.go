@@ -3,9 +3,8 @@ package rules
 import (
 	"fmt"
 
-	"github.com/hashicorp/hcl/v2"
-	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 
 	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
@@ -18,6 +17,12 @@ type FabricRoleAssignmentRecommended struct {
 	tflint.DefaultRule
 }
 
+// fabricRoleAssignmentRecommendedConfig is the rule configuration
+type fabricRoleAssignmentRecommendedConfig struct {
+	// Modules are the names of module calls that create role assignments for the resources passed to them
+	Modules []string `hclext:"role_assignment_modules,optional"`
+}
+
 func NewFabricRoleAssignmentRecommended() *FabricRoleAssignmentRecommended {
 	return &FabricRoleAssignmentRecommended{}
 }
@@ -38,29 +43,12 @@ func (r *FabricRoleAssignmentRecommended) Link() string {
 	return project.ReferenceLink(r.Name())
 }
 
-// extractResourceReference extracts "fabric_workspace.example" from expressions like:
-// - fabric_workspace.example.id
-// - fabric_workspace.example
-func extractResourceReference(expr hcl.Expression) string {
-	if traversal, ok := expr.(*hclsyntax.ScopeTraversalExpr); ok {
-		if len(traversal.Traversal) >= 2 {
-			// Traversal looks like: fabric_workspace.example.id
-			// Index 0: fabric_workspace (root)
-			// Index 1: example (resource label)
-			// Index 2: id (attribute) - optional
-
-			rootName := traversal.Traversal.RootName() // "fabric_workspace"
-
-			if attr, ok := traversal.Traversal[1].(hcl.TraverseAttr); ok {
-				// Return "fabric_workspace.example"
-				return fmt.Sprintf("%s.%s", rootName, attr.Name)
-			}
-		}
+func (r *FabricRoleAssignmentRecommended) Check(runner tflint.Runner) error {
+	ruleConfig := fabricRoleAssignmentRecommendedConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &ruleConfig); err != nil {
+		return err
 	}
-	return ""
-}
 
-func (r *FabricRoleAssignmentRecommended) Check(runner tflint.Runner) error {
 	// Define resource types and their corresponding role assignment resources
 	resourceConfigs := []struct {
 		resourceType         string
@@ -85,7 +73,7 @@ func (r *FabricRoleAssignmentRecommended) Check(runner tflint.Runner) error {
 		},
 		{
 			resourceType:         "fabric_domain",
-			roleAssignmentType:   "fabric_domain_role_assignment",
+			roleAssignmentType:   "fabric_domain_role_assignments",
 			referenceAttribute:   "domain_id",
 			displayNameAttribute: "display_name",
 			resourceTypeFriendly: "Domain",
@@ -99,8 +87,13 @@ func (r *FabricRoleAssignmentRecommended) Check(runner tflint.Runner) error {
 		},
 	}
 
+	resolver, err := newReferenceResolver(runner)
+	if err != nil {
+		return err
+	}
+
 	for _, config := range resourceConfigs {
-		if err := r.checkResourceRoleAssignments(runner, config); err != nil {
+		if err := r.checkResourceRoleAssignments(runner, resolver, ruleConfig.Modules, config); err != nil {
 			return err
 		}
 	}
@@ -110,6 +103,8 @@ func (r *FabricRoleAssignmentRecommended) Check(runner tflint.Runner) error {
 
 func (r *FabricRoleAssignmentRecommended) checkResourceRoleAssignments(
 	runner tflint.Runner,
+	resolver *referenceResolver,
+	modules []string,
 	config struct {
 		resourceType         string
 		roleAssignmentType   string
@@ -118,6 +113,10 @@ func (r *FabricRoleAssignmentRecommended) checkResourceRoleAssignments(
 		resourceTypeFriendly string
 	},
 ) error {
+	// Blocks are not expanded, so each resource is reported once and for_each stays available
+	// for resolving each.key and each.value
+	noExpand := &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone}
+
 	// Get all resources of this type
 	resourceSchema := &hclext.BodySchema{
 		Attributes: []hclext.AttributeSchema{
@@ -125,7 +124,7 @@ func (r *FabricRoleAssignmentRecommended) checkResourceRoleAssignments(
 		},
 	}
 
-	resources, err := runner.GetResourceContent(config.resourceType, resourceSchema, nil)
+	resources, err := runner.GetResourceContent(config.resourceType, resourceSchema, noExpand)
 	if err != nil {
 		return err
 	}
@@ -134,38 +133,48 @@ func (r *FabricRoleAssignmentRecommended) checkResourceRoleAssignments(
 	roleAssignmentSchema := &hclext.BodySchema{
 		Attributes: []hclext.AttributeSchema{
 			{Name: config.referenceAttribute},
+			{Name: "for_each"},
 		},
 	}
 
-	roleAssignments, err := runner.GetResourceContent(config.roleAssignmentType, roleAssignmentSchema, nil)
+	roleAssignments, err := runner.GetResourceContent(config.roleAssignmentType, roleAssignmentSchema, noExpand)
 	if err != nil {
 		return err
 	}
 
-	// Build a set of resource IDs that have role assignments
+	// Build a set of resources that have role assignments
 	resourcesWithRoles := make(map[string]bool)
 
 	for _, block := range roleAssignments.Blocks {
-		if attr, exists := block.Body.Attributes[config.referenceAttribute]; exists && attr.Expr != nil {
-			// Extract resource reference from HCL expression
-			// This handles references like fabric_workspace.example.id
-			resourceRef := extractResourceReference(attr.Expr)
+		attr, exists := block.Body.Attributes[config.referenceAttribute]
+		if !exists || attr.Expr == nil {
+			continue
+		}
 
-			if resourceRef != "" {
+		// Handles direct and indexed references as well as locals, each.value, module outputs and data sources
+		for _, resourceRef := range resolver.ResourceReferences(attr.Expr, forEachExpr(block)) {
+			resourcesWithRoles[resourceRef] = true
+			logger.Debug("role assignment target resolved", "assignment", fmt.Sprintf("%s.%s", block.Labels[0], block.Labels[1]), "target", resourceRef)
+		}
+	}
+
+	// Resources passed to a configured module get their role assignments inside that module
+	moduleArguments := resolver.ModuleArguments()
+	for _, name := range modules {
+		module := "module." + name
+		for _, resourceRef := range moduleArguments[module] {
+			if !resourcesWithRoles[resourceRef] {
 				resourcesWithRoles[resourceRef] = true
-				fmt.Printf("DEBUG: Added to map: %s\n", resourceRef)
+				logger.Debug("assuming role assignments are managed by module", "module", module, "target", resourceRef)
 			}
 		}
 	}
-	fmt.Printf("DEBUG: Map contents after building: %v\n", resourcesWithRoles)
+
 	// Check each resource
 	for _, block := range resources.Blocks {
-		fmt.Printf("DEBUG: block.Labels = %v\n", block.Labels)
 		// Get the resource reference (e.g., "fabric_workspace.example")
 		resourceRef := fmt.Sprintf("%s.%s", config.resourceType, block.Labels[1])
 
-		fmt.Printf("DEBUG: NOW checking resource: %s, in map: %v\n", resourceRef, resourcesWithRoles[resourceRef])
-
 		// If this resource doesn't have any role assignments, emit a warning
 		if !resourcesWithRoles[resourceRef] {
 			var displayName string
@@ -174,10 +183,10 @@ func (r *FabricRoleAssignmentRecommended) checkResourceRoleAssignments(
 			}
 
 			message := fmt.Sprintf("%s '%s' does not have any role assignments. This resource may not be accessible to users.",
-				config.resourceTypeFriendly, block.Labels[0])
+				config.resourceTypeFriendly, resourceRef)
 			if displayName != "" {
 				message = fmt.Sprintf("%s '%s' (%s) does not have any role assignments. This resource may not be accessible to users.",
-					config.resourceTypeFriendly, displayName, block.Labels[0])
+					config.resourceTypeFriendly, displayName, resourceRef)
 			}
 
 			if err := runner.EmitIssue(
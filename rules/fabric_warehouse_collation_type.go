@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+)
+
+// warehouseCollationTypes are the collations a warehouse can be created with
+var warehouseCollationTypes = []string{
+	"Latin1_General_100_BIN2_UTF8",
+	"Latin1_General_100_CI_AS_KS_WS_SC_UTF8",
+}
+
+// FabricWarehouseCollationType validates configuration.collation_type of fabric_warehouse
+type FabricWarehouseCollationType struct {
+	tflint.DefaultRule
+}
+
+func NewFabricWarehouseCollationType() *FabricWarehouseCollationType {
+	return &FabricWarehouseCollationType{}
+}
+
+func (r *FabricWarehouseCollationType) Name() string {
+	return "fabric_warehouse_collation_type_valid"
+}
+
+func (r *FabricWarehouseCollationType) Enabled() bool {
+	return true
+}
+
+func (r *FabricWarehouseCollationType) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *FabricWarehouseCollationType) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricWarehouseCollationType) Check(runner tflint.Runner) error {
+	resourceContent, err := runner.GetResourceContent("fabric_warehouse", nestedAttributesSchema("configuration.collation_type"), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resourceContent.Blocks {
+		expr := nestedAttributeExpr(resource.Body, "configuration.collation_type")
+		if expr == nil {
+			continue
+		}
+		var collation string
+		if err := runner.EvaluateExpr(expr, &collation, nil); err != nil || collation == "" {
+			continue
+		}
+
+		valid := false
+		for _, candidate := range warehouseCollationTypes {
+			valid = valid || collation == candidate
+		}
+		if valid {
+			continue
+		}
+
+		message := fmt.Sprintf("configuration.collation_type '%s' is invalid. Must be one of: %s", collation, strings.Join(warehouseCollationTypes, ", "))
+		for _, candidate := range warehouseCollationTypes {
+			if strings.EqualFold(collation, candidate) {
+				message = fmt.Sprintf("configuration.collation_type '%s' must be written as '%s'", collation, candidate)
+			}
+		}
+		if err := runner.EmitIssue(r, message, expr.Range()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
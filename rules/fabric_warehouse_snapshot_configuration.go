@@ -0,0 +1,142 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+)
+
+// warehouseSnapshotTimeLayout is the UTC format of snapshot_date_time
+const warehouseSnapshotTimeLayout = "2006-01-02T15:04:05Z"
+
+// FabricWarehouseSnapshotConfiguration validates the configuration of fabric_warehouse_snapshot
+// The parent must be a warehouse in the same workspace, and the snapshot time a UTC timestamp
+type FabricWarehouseSnapshotConfiguration struct {
+	tflint.DefaultRule
+}
+
+func NewFabricWarehouseSnapshotConfiguration() *FabricWarehouseSnapshotConfiguration {
+	return &FabricWarehouseSnapshotConfiguration{}
+}
+
+func (r *FabricWarehouseSnapshotConfiguration) Name() string {
+	return "fabric_warehouse_snapshot_configuration"
+}
+
+func (r *FabricWarehouseSnapshotConfiguration) Enabled() bool {
+	return true
+}
+
+func (r *FabricWarehouseSnapshotConfiguration) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *FabricWarehouseSnapshotConfiguration) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricWarehouseSnapshotConfiguration) Check(runner tflint.Runner) error {
+	schema := nestedAttributesSchema("workspace_id", "for_each", "configuration.parent_warehouse_id", "configuration.snapshot_date_time")
+
+	// Blocks are expanded, so times built from each.value or count.index are checked per instance
+	resourceContent, err := runner.GetResourceContent("fabric_warehouse_snapshot", schema, nil)
+	if err != nil {
+		return err
+	}
+	for _, resource := range resourceContent.Blocks {
+		expr := nestedAttributeExpr(resource.Body, "configuration.snapshot_date_time")
+		if expr == nil {
+			continue
+		}
+		var snapshotTime string
+		if err := runner.EvaluateExpr(expr, &snapshotTime, nil); err != nil || snapshotTime == "" {
+			continue
+		}
+		if _, err := time.Parse(warehouseSnapshotTimeLayout, snapshotTime); err == nil {
+			continue
+		}
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("configuration.snapshot_date_time '%s' is invalid. It must be a UTC time in the YYYY-MM-DDTHH:mm:ssZ format, e.g. 2025-01-31T18:00:00Z", snapshotTime),
+			expr.Range(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return r.checkParents(runner, schema)
+}
+
+// checkParents reports parent_warehouse_id values that don't refer to a warehouse in the workspace of the snapshot
+func (r *FabricWarehouseSnapshotConfiguration) checkParents(runner tflint.Runner, schema *hclext.BodySchema) error {
+	resolver, err := newReferenceResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	// References are checked per declaration, so count and for_each instances share a result
+	noExpand := &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone}
+
+	warehouseWorkspaces, err := parentItemWorkspaces(runner, resolver, "fabric_warehouse")
+	if err != nil {
+		return err
+	}
+
+	resourceContent, err := runner.GetResourceContent("fabric_warehouse_snapshot", schema, noExpand)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resourceContent.Blocks {
+		parentExpr := nestedAttributeExpr(resource.Body, "configuration.parent_warehouse_id")
+		if parentExpr == nil {
+			continue
+		}
+		address := strings.Join(resource.Labels, ".")
+
+		// A direct reference to another item, e.g. fabric_lakehouse.example.id or another snapshot
+		if reference := extractResourceReference(parentExpr); strings.HasPrefix(reference, "fabric_") && !strings.HasPrefix(reference, "fabric_warehouse.") {
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("configuration.parent_warehouse_id refers to '%s', which is not a warehouse", reference),
+				parentExpr.Range(),
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		workspaceAttr, exists := resource.Body.Attributes["workspace_id"]
+		if !exists || workspaceAttr.Expr == nil {
+			continue
+		}
+		forEach := forEachExpr(resource)
+		snapshotWorkspaces, ok := resolver.WorkspaceReferences(workspaceAttr.Expr, forEach)
+		if !ok {
+			// Data sources, module outputs and fabric_warehouse.example.workspace_id are not compared
+			logger.Debug("workspace of warehouse snapshot is not known statically", "resource", address)
+			continue
+		}
+		others := parentsInOtherWorkspaces(resolver, "fabric_warehouse", parentExpr, forEach, snapshotWorkspaces, warehouseWorkspaces)
+		if len(others) == 0 {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("Warehouse snapshot '%s' is created in '%s', but its parent warehouse %s is in another workspace. Snapshots must be in the workspace of their warehouse",
+				address, strings.Join(snapshotWorkspaces, "' or '"), strings.Join(others, " or ")),
+			parentExpr.Range(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,250 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+)
+
+// FabricWorkspaceIdentityRequired reports features that need a workspace identity in a fabric_workspace without one
+// Managed private endpoints, WorkspaceIdentity connections and trusted workspace access all authenticate as the workspace
+type FabricWorkspaceIdentityRequired struct {
+	tflint.DefaultRule
+}
+
+// fabricWorkspaceIdentityRequiredConfig is the rule configuration
+type fabricWorkspaceIdentityRequiredConfig struct {
+	// ADLSGen2Shortcuts treats every ADLS Gen2 shortcut as using trusted workspace access
+	ADLSGen2Shortcuts bool `hclext:"adls_gen2_shortcuts,optional"`
+}
+
+// identityDependent is a resource that needs the identity of the workspace it is created in
+type identityDependent struct {
+	address   string
+	reason    string
+	workspace *hclext.Attribute
+	block     *hclext.Block
+}
+
+// shortcutTargets are the fabric_shortcut targets that authenticate through a connection
+var shortcutTargets = []string{
+	"adls_gen2",
+	"amazon_s3",
+	"azure_blob_storage",
+	"dataverse",
+	"external_data_share",
+	"google_cloud_storage",
+	"s3_compatible",
+}
+
+func NewFabricWorkspaceIdentityRequired() *FabricWorkspaceIdentityRequired {
+	return &FabricWorkspaceIdentityRequired{}
+}
+
+func (r *FabricWorkspaceIdentityRequired) Name() string {
+	return "fabric_workspace_identity_required"
+}
+
+func (r *FabricWorkspaceIdentityRequired) Enabled() bool {
+	return true
+}
+
+func (r *FabricWorkspaceIdentityRequired) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *FabricWorkspaceIdentityRequired) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricWorkspaceIdentityRequired) Check(runner tflint.Runner) error {
+	config := fabricWorkspaceIdentityRequiredConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	resolver, err := newReferenceResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	// Identity is checked per declaration, so count and for_each instances share a result
+	noExpand := &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone}
+
+	withoutIdentity, err := r.workspacesWithoutIdentity(runner, noExpand)
+	if err != nil {
+		return err
+	}
+
+	dependents, err := r.managedPrivateEndpoints(runner, noExpand)
+	if err != nil {
+		return err
+	}
+	shortcuts, err := r.shortcuts(runner, resolver, config, noExpand)
+	if err != nil {
+		return err
+	}
+	dependents = append(dependents, shortcuts...)
+
+	for _, dependent := range dependents {
+		workspaces, ok := resolver.WorkspaceReferences(dependent.workspace.Expr, forEachExpr(dependent.block))
+		if !ok {
+			// Data sources and module outputs may point at any workspace
+			logger.Debug("workspace of identity dependent is not known statically", "resource", dependent.address)
+			continue
+		}
+
+		var targets []string
+		for _, workspace := range workspaces {
+			if !withoutIdentity[workspace] {
+				targets = nil
+				break
+			}
+			targets = append(targets, workspace)
+		}
+		if len(targets) == 0 {
+			continue
+		}
+
+		if err := runner.EmitIssue(
+			r,
+			fmt.Sprintf("Workspace '%s' has no identity, but %s requires one. Add identity { type = \"SystemAssigned\" } to the workspace",
+				strings.Join(targets, "' or '"), dependent.reason),
+			dependent.workspace.Range,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// workspacesWithoutIdentity returns the fabric_workspace resources declared without a SystemAssigned identity
+func (r *FabricWorkspaceIdentityRequired) workspacesWithoutIdentity(runner tflint.Runner, opts *tflint.GetModuleContentOption) (map[string]bool, error) {
+	workspaces, err := runner.GetResourceContent("fabric_workspace", nestedAttributesSchema("identity.type"), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	withoutIdentity := make(map[string]bool)
+	for _, block := range workspaces.Blocks {
+		address := strings.Join(block.Labels, ".")
+
+		if attr, exists := block.Body.Attributes["identity"]; exists && isNullAttribute(runner, attr) {
+			withoutIdentity[address] = true
+			continue
+		}
+		if !hasNestedObject(block.Body, "identity") {
+			withoutIdentity[address] = true
+			continue
+		}
+
+		// An identity whose type is not known, e.g. identity = var.identity, is assumed to be SystemAssigned
+		if expr := nestedAttributeExpr(block.Body, "identity.type"); expr != nil {
+			var identityType string
+			if err := runner.EvaluateExpr(expr, &identityType, nil); err == nil && !strings.EqualFold(identityType, "SystemAssigned") {
+				withoutIdentity[address] = true
+			}
+		}
+	}
+	return withoutIdentity, nil
+}
+
+// managedPrivateEndpoints returns the fabric_workspace_managed_private_endpoint resources
+func (r *FabricWorkspaceIdentityRequired) managedPrivateEndpoints(runner tflint.Runner, opts *tflint.GetModuleContentOption) ([]identityDependent, error) {
+	endpoints, err := runner.GetResourceContent("fabric_workspace_managed_private_endpoint", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "workspace_id"},
+			{Name: "for_each"},
+		},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var dependents []identityDependent
+	for _, block := range endpoints.Blocks {
+		attr, exists := block.Body.Attributes["workspace_id"]
+		if !exists || attr.Expr == nil {
+			continue
+		}
+		address := strings.Join(block.Labels, ".")
+		dependents = append(dependents, identityDependent{
+			address:   address,
+			reason:    fmt.Sprintf("managed private endpoint '%s'", address),
+			workspace: attr,
+			block:     block,
+		})
+	}
+	return dependents, nil
+}
+
+// shortcuts returns the fabric_shortcut resources that use a WorkspaceIdentity connection or trusted workspace access
+func (r *FabricWorkspaceIdentityRequired) shortcuts(runner tflint.Runner, resolver *referenceResolver, config fabricWorkspaceIdentityRequiredConfig, opts *tflint.GetModuleContentOption) ([]identityDependent, error) {
+	connections, err := runner.GetResourceContent("fabric_connection", nestedAttributesSchema("credential_details.credential_type"), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceIdentityConnections := make(map[string]bool)
+	for _, block := range connections.Blocks {
+		expr := nestedAttributeExpr(block.Body, "credential_details.credential_type")
+		if expr == nil {
+			continue
+		}
+		var credentialType string
+		if err := runner.EvaluateExpr(expr, &credentialType, nil); err == nil && credentialType == "WorkspaceIdentity" {
+			workspaceIdentityConnections[strings.Join(block.Labels, ".")] = true
+		}
+	}
+
+	paths := []string{"workspace_id", "for_each"}
+	for _, target := range shortcutTargets {
+		paths = append(paths, fmt.Sprintf("target.%s.connection_id", target))
+	}
+	shortcuts, err := runner.GetResourceContent("fabric_shortcut", nestedAttributesSchema(paths...), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var dependents []identityDependent
+	for _, block := range shortcuts.Blocks {
+		attr, exists := block.Body.Attributes["workspace_id"]
+		if !exists || attr.Expr == nil {
+			continue
+		}
+		address := strings.Join(block.Labels, ".")
+
+		var reason string
+		for _, target := range shortcutTargets {
+			expr := nestedAttributeExpr(block.Body, fmt.Sprintf("target.%s.connection_id", target))
+			if expr == nil {
+				continue
+			}
+			for _, connection := range resolver.ResourceReferences(expr, forEachExpr(block)) {
+				if workspaceIdentityConnections[connection] {
+					reason = fmt.Sprintf("shortcut '%s' using WorkspaceIdentity connection '%s'", address, connection)
+				}
+			}
+			if reason == "" && target == "adls_gen2" && config.ADLSGen2Shortcuts {
+				reason = fmt.Sprintf("ADLS Gen2 shortcut '%s' using trusted workspace access", address)
+			}
+		}
+		if reason == "" {
+			continue
+		}
+
+		dependents = append(dependents, identityDependent{
+			address:   address,
+			reason:    reason,
+			workspace: attr,
+			block:     block,
+		})
+	}
+	return dependents, nil
+}
@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+)
+
+// FabricWorkspaceManagedPrivateEndpointRequestMessage warns when managed private endpoints don't have a request message
+// The message is shown to the owner of the target resource, who has to approve the connection
+type FabricWorkspaceManagedPrivateEndpointRequestMessage struct {
+	tflint.DefaultRule
+}
+
+func NewFabricWorkspaceManagedPrivateEndpointRequestMessage() *FabricWorkspaceManagedPrivateEndpointRequestMessage {
+	return &FabricWorkspaceManagedPrivateEndpointRequestMessage{}
+}
+
+func (r *FabricWorkspaceManagedPrivateEndpointRequestMessage) Name() string {
+	return "fabric_workspace_managed_private_endpoint_request_message"
+}
+
+func (r *FabricWorkspaceManagedPrivateEndpointRequestMessage) Enabled() bool {
+	return true
+}
+
+func (r *FabricWorkspaceManagedPrivateEndpointRequestMessage) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+func (r *FabricWorkspaceManagedPrivateEndpointRequestMessage) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricWorkspaceManagedPrivateEndpointRequestMessage) Check(runner tflint.Runner) error {
+	resourceContent, err := runner.GetResourceContent("fabric_workspace_managed_private_endpoint", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "request_message"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resourceContent.Blocks {
+		attr, exists := resource.Body.Attributes["request_message"]
+		if !exists || attr.Expr == nil {
+			if err := runner.EmitIssue(
+				r,
+				"Managed private endpoint should have a request_message. The owner of the target resource sees it when approving the connection",
+				resource.DefRange,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var message string
+		if err := runner.EvaluateExpr(attr.Expr, &message, nil); err == nil && strings.TrimSpace(message) == "" {
+			if err := runner.EmitIssue(
+				r,
+				"request_message is empty. Describe who requests the connection and why, so the owner of the target resource can approve it",
+				attr.Range,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/RuneORakeie/tflint-ruleset-fabric/project"
+)
+
+// armResourceIDPattern matches /subscriptions/{id}/resourceGroups/{name}/providers/{namespace}/{type}/{name}[/{type}/{name}...]
+var armResourceIDPattern = regexp.MustCompile(`(?i)^/subscriptions/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}/resourceGroups/[^/]+/providers/([a-z0-9]+\.[a-z0-9.]+)/([^/]+)/[^/]+(/[^/]+/[^/]+)*$`)
+
+// privateLinkSubresources are the private link sub-resources (group IDs) of each ARM resource type, keyed by lower case type
+var privateLinkSubresources = map[string][]string{
+	"microsoft.cognitiveservices/accounts":         {"account"},
+	"microsoft.databricks/workspaces":              {"browser_authentication", "databricks_ui_api"},
+	"microsoft.datafactory/factories":              {"dataFactory", "portal"},
+	"microsoft.dbformysql/flexibleservers":         {"mysqlServer"},
+	"microsoft.dbforpostgresql/flexibleservers":    {"postgresqlServer"},
+	"microsoft.devices/iothubs":                    {"iotHub"},
+	"microsoft.documentdb/databaseaccounts":        {"Analytical", "Cassandra", "Gremlin", "MongoDB", "Sql", "Table"},
+	"microsoft.eventhub/namespaces":                {"namespace"},
+	"microsoft.keyvault/vaults":                    {"vault"},
+	"microsoft.kusto/clusters":                     {"cluster"},
+	"microsoft.machinelearningservices/workspaces": {"amlworkspace"},
+	"microsoft.purview/accounts":                   {"account", "portal"},
+	"microsoft.search/searchservices":              {"searchService"},
+	"microsoft.servicebus/namespaces":              {"namespace"},
+	"microsoft.sql/managedinstances":               {"managedInstance"},
+	"microsoft.sql/servers":                        {"sqlServer"},
+	"microsoft.storage/storageaccounts":            {"blob", "blob_secondary", "dfs", "dfs_secondary", "file", "queue", "queue_secondary", "table", "table_secondary", "web", "web_secondary"},
+	"microsoft.synapse/workspaces":                 {"Dev", "Sql", "SqlOnDemand"},
+}
+
+// azurermResourceTypes maps azurerm resources referenced by target_private_link_resource_id to their ARM type
+var azurermResourceTypes = map[string]string{
+	"azurerm_cognitive_account":          "Microsoft.CognitiveServices/accounts",
+	"azurerm_cosmosdb_account":           "Microsoft.DocumentDB/databaseAccounts",
+	"azurerm_data_factory":               "Microsoft.DataFactory/factories",
+	"azurerm_databricks_workspace":       "Microsoft.Databricks/workspaces",
+	"azurerm_eventhub_namespace":         "Microsoft.EventHub/namespaces",
+	"azurerm_iothub":                     "Microsoft.Devices/IotHubs",
+	"azurerm_key_vault":                  "Microsoft.KeyVault/vaults",
+	"azurerm_kusto_cluster":              "Microsoft.Kusto/clusters",
+	"azurerm_machine_learning_workspace": "Microsoft.MachineLearningServices/workspaces",
+	"azurerm_mssql_managed_instance":     "Microsoft.Sql/managedInstances",
+	"azurerm_mssql_server":               "Microsoft.Sql/servers",
+	"azurerm_mysql_flexible_server":      "Microsoft.DBforMySQL/flexibleServers",
+	"azurerm_postgresql_flexible_server": "Microsoft.DBforPostgreSQL/flexibleServers",
+	"azurerm_purview_account":            "Microsoft.Purview/accounts",
+	"azurerm_search_service":             "Microsoft.Search/searchServices",
+	"azurerm_servicebus_namespace":       "Microsoft.ServiceBus/namespaces",
+	"azurerm_storage_account":            "Microsoft.Storage/storageAccounts",
+	"azurerm_synapse_workspace":          "Microsoft.Synapse/workspaces",
+}
+
+// FabricWorkspaceManagedPrivateEndpointTarget validates the target of managed private endpoints
+// The resource ID must be a well-formed ARM ID, the sub-resource must exist for its resource type,
+// and a workspace can have only one endpoint per target and sub-resource
+type FabricWorkspaceManagedPrivateEndpointTarget struct {
+	tflint.DefaultRule
+}
+
+func NewFabricWorkspaceManagedPrivateEndpointTarget() *FabricWorkspaceManagedPrivateEndpointTarget {
+	return &FabricWorkspaceManagedPrivateEndpointTarget{}
+}
+
+func (r *FabricWorkspaceManagedPrivateEndpointTarget) Name() string {
+	return "fabric_workspace_managed_private_endpoint_target"
+}
+
+func (r *FabricWorkspaceManagedPrivateEndpointTarget) Enabled() bool {
+	return true
+}
+
+func (r *FabricWorkspaceManagedPrivateEndpointTarget) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+func (r *FabricWorkspaceManagedPrivateEndpointTarget) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+func (r *FabricWorkspaceManagedPrivateEndpointTarget) Check(runner tflint.Runner) error {
+	resolver, err := newReferenceResolver(runner)
+	if err != nil {
+		return err
+	}
+
+	// Blocks are not expanded, so the test runner sees count and for_each instances too.
+	// blockInstances expands them, and targets built from each.value or count.index are checked per instance
+	resourceContent, err := runner.GetResourceContent("fabric_workspace_managed_private_endpoint", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "workspace_id"},
+			{Name: "target_private_link_resource_id"},
+			{Name: "target_subresource_type"},
+			{Name: "for_each"},
+			{Name: "count"},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]blockInstance)
+
+	for _, resource := range resourceContent.Blocks {
+		targetAttr, exists := resource.Body.Attributes["target_private_link_resource_id"]
+		if !exists || targetAttr.Expr == nil {
+			continue
+		}
+		forEach := forEachExpr(resource)
+
+		for _, instance := range blockInstances(runner, resource) {
+			target, resourceType, ok, err := r.checkTarget(runner, targetAttr, instance.ctx)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+
+			var subresource string
+			subresourceAttr, exists := resource.Body.Attributes["target_subresource_type"]
+			if exists && subresourceAttr.Expr != nil {
+				if value, ok := evaluateInstanceExpr(runner, subresourceAttr.Expr, instance.ctx); ok && value.Type() == cty.String {
+					subresource = value.AsString()
+				}
+				if subresource != "" && resourceType != "" {
+					if err := r.checkSubresource(runner, subresourceAttr, subresource, resourceType); err != nil {
+						return err
+					}
+				}
+			}
+
+			// Instances of a counted or for_each target or workspace are told apart by their index,
+			// e.g. azurerm_storage_account.example[each.key].id
+			if target == "" {
+				target = resolver.ResolveInstanceID(runner, targetAttr.Expr, forEach, instance.ctx)
+			}
+			workspaceAttr, exists := resource.Body.Attributes["workspace_id"]
+			if !exists || target == "" || subresource == "" {
+				continue
+			}
+			workspace := resolver.ResolveInstanceID(runner, workspaceAttr.Expr, forEach, instance.ctx)
+			if workspace == "" {
+				continue
+			}
+
+			key := fmt.Sprintf("%s|%s|%s", workspace, target, strings.ToLower(subresource))
+			first, exists := seen[key]
+			if !exists {
+				seen[key] = instance
+				continue
+			}
+			if err := runner.EmitIssue(
+				r,
+				fmt.Sprintf("A managed private endpoint to '%s' (%s) is already defined by %s (%s) in workspace '%s'",
+					target, subresource, first.address, first.block.DefRange.String(), workspace),
+				targetAttr.Range,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkTarget validates a literal resource ID, or finds the ARM type of a referenced azurerm resource
+// It returns the lower case literal ID ("" for references), the ARM type ("" when unknown),
+// and false when the ID is malformed. ctx is the context of the instance, see blockInstances
+func (r *FabricWorkspaceManagedPrivateEndpointTarget) checkTarget(runner tflint.Runner, attr *hclext.Attribute, ctx *hcl.EvalContext) (string, string, bool, error) {
+	var id string
+	if value, ok := evaluateInstanceExpr(runner, attr.Expr, ctx); ok && value.Type() == cty.String {
+		id = value.AsString()
+	}
+	if id == "" {
+		// Not a literal, e.g. azurerm_storage_account.example.id
+		if reference := extractResourceReference(attr.Expr); reference != "" {
+			resourceType, _, _ := strings.Cut(reference, ".")
+			return "", azurermResourceTypes[resourceType], true, nil
+		}
+		return "", "", true, nil
+	}
+
+	match := armResourceIDPattern.FindStringSubmatch(id)
+	if match == nil {
+		return "", "", false, runner.EmitIssue(
+			r,
+			fmt.Sprintf("target_private_link_resource_id '%s' is not a well-formed Azure resource ID. Expected /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}", id),
+			attr.Range,
+		)
+	}
+
+	return strings.ToLower(id), match[1] + "/" + match[2], true, nil
+}
+
+// checkSubresource reports a sub-resource that does not exist for the ARM resource type
+func (r *FabricWorkspaceManagedPrivateEndpointTarget) checkSubresource(runner tflint.Runner, attr *hclext.Attribute, subresource string, resourceType string) error {
+	valid, known := privateLinkSubresources[strings.ToLower(resourceType)]
+	if !known {
+		return nil
+	}
+	for _, candidate := range valid {
+		if strings.EqualFold(candidate, subresource) {
+			return nil
+		}
+	}
+
+	sorted := append([]string(nil), valid...)
+	sort.Strings(sorted)
+	return runner.EmitIssue(
+		r,
+		fmt.Sprintf("target_subresource_type '%s' is not valid for %s. Valid values: %s", subresource, resourceType, strings.Join(sorted, ", ")),
+		attr.Range,
+	)
+}
@@ -0,0 +1,153 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// blockInstance is one instance of a block read with tflint.ExpandModeNone, e.g. fabric_lakehouse.example["dev"]
+type blockInstance struct {
+	block   *hclext.Block
+	address string
+	// ctx holds each or count of the instance, nil for blocks without for_each and count
+	ctx *hcl.EvalContext
+}
+
+// blockInstances returns the instances a block creates, with each or count in their context.
+// The block must have been fetched with for_each and count in its schema.
+// A block with a for_each or count that can't be evaluated creates no instances
+func blockInstances(runner tflint.Runner, block *hclext.Block) []blockInstance {
+	address := strings.Join(block.Labels, ".")
+
+	if attr, exists := block.Body.Attributes["for_each"]; exists {
+		var collection cty.Value
+		if err := runner.EvaluateExpr(attr.Expr, &collection, nil); err != nil || !collection.IsWhollyKnown() || collection.IsNull() || !collection.CanIterateElements() {
+			return nil
+		}
+
+		var instances []blockInstance
+		for it := collection.ElementIterator(); it.Next(); {
+			// The keys of a set are its values
+			key, value := it.Element()
+			if key.Type() != cty.String {
+				return nil
+			}
+			instances = append(instances, blockInstance{
+				block:   block,
+				address: address + instanceKey(key),
+				ctx: &hcl.EvalContext{Variables: map[string]cty.Value{
+					"each": cty.ObjectVal(map[string]cty.Value{"key": key, "value": value}),
+				}},
+			})
+		}
+		return instances
+	}
+
+	if attr, exists := block.Body.Attributes["count"]; exists {
+		var count int
+		if err := runner.EvaluateExpr(attr.Expr, &count, nil); err != nil {
+			return nil
+		}
+
+		instances := make([]blockInstance, 0, count)
+		for index := 0; index < count; index++ {
+			instances = append(instances, blockInstance{
+				block:   block,
+				address: address + instanceKey(cty.NumberIntVal(int64(index))),
+				ctx: &hcl.EvalContext{Variables: map[string]cty.Value{
+					"count": cty.ObjectVal(map[string]cty.Value{"index": cty.NumberIntVal(int64(index))}),
+				}},
+			})
+		}
+		return instances
+	}
+
+	return []blockInstance{{block: block, address: address}}
+}
+
+// evaluateInstanceExpr evaluates expr for one instance of a block.
+// Expressions that refer to each or count are evaluated with only the instance variables in scope,
+// so anything else they refer to is unknown. Other expressions are evaluated by the runner
+func evaluateInstanceExpr(runner tflint.Runner, expr hcl.Expression, ctx *hcl.EvalContext) (cty.Value, bool) {
+	if expr == nil {
+		return cty.NilVal, false
+	}
+
+	if ctx != nil && usesInstanceVariables(expr) {
+		value, diags := expr.Value(ctx)
+		if diags.HasErrors() || !value.IsKnown() || value.IsNull() {
+			return cty.NilVal, false
+		}
+		return value, true
+	}
+
+	var value cty.Value
+	if err := runner.EvaluateExpr(expr, &value, nil); err != nil || !value.IsKnown() || value.IsNull() {
+		return cty.NilVal, false
+	}
+	return value, true
+}
+
+// usesInstanceVariables reports whether expr refers to each or count
+func usesInstanceVariables(expr hcl.Expression) bool {
+	for _, traversal := range expr.Variables() {
+		if root := traversal.RootName(); root == "each" || root == "count" {
+			return true
+		}
+	}
+	return false
+}
+
+// instanceReference returns the managed resource instance an indexed reference points to, e.g. `fabric_workspace.env["dev"]`
+// for fabric_workspace.env["dev"].id, fabric_workspace.env[each.key].id or fabric_workspace.env[count.index].id.
+// The index is evaluated like evaluateInstanceExpr. indexed is false when expr is not an indexed resource reference,
+// and the reference is "" when the index can't be evaluated
+func instanceReference(runner tflint.Runner, expr hcl.Expression, ctx *hcl.EvalContext) (reference string, indexed bool) {
+	// fabric_workspace.env[each.key].id is the id attribute of an index expression
+	if relative, ok := expr.(*hclsyntax.RelativeTraversalExpr); ok {
+		expr = relative.Source
+	}
+
+	switch expr := expr.(type) {
+	case *hclsyntax.ScopeTraversalExpr:
+		// Literal indexes stay in the traversal, e.g. fabric_workspace.env["dev"].id
+		resource := extractResourceReference(expr)
+		if resource == "" || len(expr.Traversal) < 3 {
+			return "", false
+		}
+		index, ok := expr.Traversal[2].(hcl.TraverseIndex)
+		if !ok {
+			return "", false
+		}
+		return resource + instanceKey(index.Key), true
+	case *hclsyntax.IndexExpr:
+		collection, ok := expr.Collection.(*hclsyntax.ScopeTraversalExpr)
+		if !ok || len(collection.Traversal) != 2 {
+			return "", false
+		}
+		resource := extractResourceReference(collection)
+		if resource == "" {
+			return "", false
+		}
+		key, ok := evaluateInstanceExpr(runner, expr.Key, ctx)
+		if !ok || (key.Type() != cty.String && key.Type() != cty.Number) {
+			return "", true
+		}
+		return resource + instanceKey(key), true
+	}
+	return "", false
+}
+
+// instanceKey formats the key of a resource instance like Terraform addresses, e.g. ["dev"] or [0]
+func instanceKey(key cty.Value) string {
+	if key.Type() == cty.String {
+		return fmt.Sprintf("[%q]", key.AsString())
+	}
+	return fmt.Sprintf("[%s]", key.AsBigFloat().Text('f', -1))
+}
@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// The provider defines nested objects such as identity or target as attributes (identity = { ... }),
+// but many configurations write them as blocks (identity { ... }). These helpers read both forms.
+
+// nestedAttributesSchema returns a schema for the dotted attribute paths, e.g. "target.adls_gen2.connection_id"
+// Every object along a path is read both as an attribute and as a block
+func nestedAttributesSchema(paths ...string) *hclext.BodySchema {
+	split := make([][]string, 0, len(paths))
+	for _, path := range paths {
+		split = append(split, strings.Split(path, "."))
+	}
+	return nestedSchema(split)
+}
+
+func nestedSchema(paths [][]string) *hclext.BodySchema {
+	schema := &hclext.BodySchema{}
+	children := make(map[string][][]string)
+	var order []string
+
+	for _, path := range paths {
+		name := path[0]
+		if _, exists := children[name]; !exists {
+			order = append(order, name)
+			schema.Attributes = append(schema.Attributes, hclext.AttributeSchema{Name: name})
+			children[name] = nil
+		}
+		if len(path) > 1 {
+			children[name] = append(children[name], path[1:])
+		}
+	}
+
+	for _, name := range order {
+		if len(children[name]) > 0 {
+			schema.Blocks = append(schema.Blocks, hclext.BlockSchema{Type: name, Body: nestedSchema(children[name])})
+		}
+	}
+	return schema
+}
+
+// nestedAttributeExpr returns the expression at the dotted attribute path, or nil when it is not set
+// body must have been fetched with nestedAttributesSchema
+func nestedAttributeExpr(body *hclext.BodyContent, path string) hcl.Expression {
+	return nestedExpr(body, strings.Split(path, "."))
+}
+
+func nestedExpr(body *hclext.BodyContent, path []string) hcl.Expression {
+	if attr, exists := body.Attributes[path[0]]; exists {
+		if len(path) == 1 {
+			return attr.Expr
+		}
+		return objectItemExpr(attr.Expr, path[1:])
+	}
+	if len(path) == 1 {
+		return nil
+	}
+	for _, block := range body.Blocks.OfType(path[0]) {
+		if expr := nestedExpr(block.Body, path[1:]); expr != nil {
+			return expr
+		}
+	}
+	return nil
+}
+
+// objectItemExpr returns the expression at path inside an object constructor, or nil
+func objectItemExpr(expr hcl.Expression, path []string) hcl.Expression {
+	object, ok := expr.(*hclsyntax.ObjectConsExpr)
+	if !ok {
+		return nil
+	}
+	for _, item := range object.Items {
+		if objectKeyName(item.KeyExpr) != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			return item.ValueExpr
+		}
+		return objectItemExpr(item.ValueExpr, path[1:])
+	}
+	return nil
+}
+
+// hasNestedObject reports whether the object at name is set as an attribute or a block
+func hasNestedObject(body *hclext.BodyContent, name string) bool {
+	if attr, exists := body.Attributes[name]; exists {
+		return attr.Expr != nil
+	}
+	return len(body.Blocks.OfType(name)) > 0
+}
+
+// objectKeyName returns the name of an object key written as an identifier or a string
+func objectKeyName(expr hclsyntax.Expression) string {
+	if name := hcl.ExprAsKeyword(expr); name != "" {
+		return name
+	}
+	value, diags := expr.Value(nil)
+	if diags.HasErrors() || !value.IsKnown() || value.Type() != cty.String {
+		return ""
+	}
+	return value.AsString()
+}
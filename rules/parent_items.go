@@ -0,0 +1,71 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// Some items are created inside a parent item, e.g. KQL databases in an eventhouse or snapshots of a warehouse.
+// The parent must be in the same workspace, which these helpers compare through the workspace references of both.
+
+// parentItemWorkspaces returns the workspaces of each resource of resourceType, when they are known statically
+func parentItemWorkspaces(runner tflint.Runner, resolver *referenceResolver, resourceType string) (map[string][]string, error) {
+	content, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "workspace_id"},
+			{Name: "for_each"},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := make(map[string][]string)
+	for _, block := range content.Blocks {
+		attr, exists := block.Body.Attributes["workspace_id"]
+		if !exists || attr.Expr == nil {
+			continue
+		}
+		if references, ok := resolver.WorkspaceReferences(attr.Expr, forEachExpr(block)); ok {
+			workspaces[strings.Join(block.Labels, ".")] = references
+		}
+	}
+	return workspaces, nil
+}
+
+// parentsInOtherWorkspaces returns the parents of resourceType that parentExpr refers to, as "address (workspaces)",
+// when none of them shares a workspace with workspaces. It returns nil when any parent is in one of the workspaces
+// or its workspace is not known.
+func parentsInOtherWorkspaces(resolver *referenceResolver, resourceType string, parentExpr hcl.Expression, forEach hcl.Expression, workspaces []string, parentWorkspaces map[string][]string) []string {
+	parents, ok := resolver.StaticResourceReferences(parentExpr, forEach)
+	if !ok {
+		return nil
+	}
+
+	inWorkspace := make(map[string]bool, len(workspaces))
+	for _, workspace := range workspaces {
+		inWorkspace[workspace] = true
+	}
+
+	var others []string
+	for _, parent := range parents {
+		if !strings.HasPrefix(parent, resourceType+".") {
+			continue
+		}
+		references, known := parentWorkspaces[parent]
+		if !known {
+			return nil
+		}
+		for _, workspace := range references {
+			if inWorkspace[workspace] {
+				return nil
+			}
+		}
+		others = append(others, fmt.Sprintf("%s (%s)", parent, strings.Join(references, ", ")))
+	}
+	return others
+}
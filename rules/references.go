@@ -0,0 +1,291 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// dataSourceLookupAttributes are the data source arguments followed when resolving data.* references
+// Fabric data sources look resources up by ID or display name, optionally scoped to a workspace
+var dataSourceLookupAttributes = []string{"id", "display_name", "workspace_id"}
+
+// referenceResolver follows expressions back to the managed resources they refer to
+// It looks through locals, each.key/each.value, module outputs and data sources
+type referenceResolver struct {
+	locals      map[string]hcl.Expression
+	modules     map[string][]hcl.Expression
+	dataSources map[string][]hcl.Expression
+}
+
+// newReferenceResolver loads the locals, module calls and data sources of the current module
+func newReferenceResolver(runner tflint.Runner) (*referenceResolver, error) {
+	justAttributes := &hclext.BodySchema{Mode: hclext.SchemaJustAttributesMode}
+
+	dataAttributes := make([]hclext.AttributeSchema, 0, len(dataSourceLookupAttributes))
+	for _, name := range dataSourceLookupAttributes {
+		dataAttributes = append(dataAttributes, hclext.AttributeSchema{Name: name})
+	}
+
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "locals", Body: justAttributes},
+			{Type: "module", LabelNames: []string{"name"}, Body: justAttributes},
+			{Type: "data", LabelNames: []string{"type", "name"}, Body: &hclext.BodySchema{Attributes: dataAttributes}},
+		},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeNone})
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := &referenceResolver{
+		locals:      make(map[string]hcl.Expression),
+		modules:     make(map[string][]hcl.Expression),
+		dataSources: make(map[string][]hcl.Expression),
+	}
+
+	for _, block := range content.Blocks {
+		switch block.Type {
+		case "locals":
+			for name, attr := range block.Body.Attributes {
+				resolver.locals[name] = attr.Expr
+			}
+		case "module":
+			resolver.modules[block.Labels[0]] = attributeExprs(block.Body.Attributes)
+		case "data":
+			address := fmt.Sprintf("data.%s.%s", block.Labels[0], block.Labels[1])
+			resolver.dataSources[address] = attributeExprs(block.Body.Attributes)
+		}
+	}
+
+	return resolver, nil
+}
+
+// ResourceReferences returns the managed resources (e.g. "fabric_workspace.example") that expr refers to
+// forEach is the for_each expression of the block that owns expr, and is used to resolve each.key and each.value
+func (r *referenceResolver) ResourceReferences(expr hcl.Expression, forEach hcl.Expression) []string {
+	references, _ := r.StaticResourceReferences(expr, forEach)
+	return references
+}
+
+// StaticResourceReferences is like ResourceReferences, but also reports whether expr is known statically
+// It returns false when expr passes through a module output or data source, whose value is only known at apply
+func (r *referenceResolver) StaticResourceReferences(expr hcl.Expression, forEach hcl.Expression) ([]string, bool) {
+	found := make(map[string]bool)
+	visited := make(map[string]bool)
+	r.collect(expr, forEach, found, visited)
+
+	static := true
+	for key := range visited {
+		if strings.HasPrefix(key, "module.") || strings.HasPrefix(key, "data.") {
+			static = false
+		}
+	}
+
+	references := make([]string, 0, len(found))
+	for reference := range found {
+		references = append(references, reference)
+	}
+	sort.Strings(references)
+	return references, static
+}
+
+// WorkspaceReferences returns the fabric_workspace resources expr refers to
+// It returns false when expr is not known statically, or also refers to resources other than workspaces,
+// e.g. fabric_lakehouse.example.workspace_id
+func (r *referenceResolver) WorkspaceReferences(expr hcl.Expression, forEach hcl.Expression) ([]string, bool) {
+	references, static := r.StaticResourceReferences(expr, forEach)
+	if !static {
+		return nil, false
+	}
+	for _, reference := range references {
+		if !strings.HasPrefix(reference, "fabric_workspace.") {
+			return nil, false
+		}
+	}
+	return references, true
+}
+
+// ModuleArguments returns the managed resources passed to each module call, keyed by "module.<name>"
+func (r *referenceResolver) ModuleArguments() map[string][]string {
+	arguments := make(map[string][]string, len(r.modules))
+	for name, exprs := range r.modules {
+		found := make(map[string]bool)
+		for _, expr := range exprs {
+			r.collect(expr, nil, found, make(map[string]bool))
+		}
+		for reference := range found {
+			arguments["module."+name] = append(arguments["module."+name], reference)
+		}
+		sort.Strings(arguments["module."+name])
+	}
+	return arguments
+}
+
+func (r *referenceResolver) collect(expr hcl.Expression, forEach hcl.Expression, found map[string]bool, visited map[string]bool) {
+	if expr == nil {
+		return
+	}
+
+	for _, traversal := range expr.Variables() {
+		switch root := traversal.RootName(); root {
+		case "local":
+			name := traversalAttrName(traversal, 1)
+			if name == "" || visited["local."+name] {
+				continue
+			}
+			visited["local."+name] = true
+			if localExpr, exists := r.locals[name]; exists {
+				r.collect(localExpr, nil, found, visited)
+			} else {
+				logger.Debug("local value not found", "name", name)
+			}
+		case "each":
+			if forEach == nil || visited["each"] {
+				continue
+			}
+			visited["each"] = true
+			r.collect(forEach, nil, found, visited)
+		case "module":
+			name := traversalAttrName(traversal, 1)
+			if name == "" || visited["module."+name] {
+				continue
+			}
+			visited["module."+name] = true
+			// Module outputs usually pass through the resources the module was given
+			logger.Debug("following module output through module arguments", "module", name)
+			for _, argument := range r.modules[name] {
+				r.collect(argument, nil, found, visited)
+			}
+		case "data":
+			dataType, name := traversalAttrName(traversal, 1), traversalAttrName(traversal, 2)
+			address := fmt.Sprintf("data.%s.%s", dataType, name)
+			if dataType == "" || name == "" || visited[address] {
+				continue
+			}
+			visited[address] = true
+			logger.Debug("following data source lookup arguments", "data_source", address)
+			for _, argument := range r.dataSources[address] {
+				r.collect(argument, nil, found, visited)
+			}
+		case "var", "count", "path", "terraform", "self":
+			// Not a reference to a managed resource
+		default:
+			if name := traversalAttrName(traversal, 1); name != "" {
+				found[fmt.Sprintf("%s.%s", root, name)] = true
+			}
+		}
+	}
+}
+
+// ResolveID returns the literal ID expr evaluates to (lower case), otherwise the single managed resource it refers to
+// It returns "" when expr cannot be resolved to one target
+func (r *referenceResolver) ResolveID(runner tflint.Runner, expr hcl.Expression, forEach hcl.Expression) string {
+	if expr == nil {
+		return ""
+	}
+
+	var id string
+	if err := runner.EvaluateExpr(expr, &id, nil); err == nil && id != "" {
+		return strings.ToLower(id)
+	}
+
+	references := r.ResourceReferences(expr, forEach)
+	if len(references) != 1 {
+		return ""
+	}
+	return references[0]
+}
+
+// ResolveInstanceID is ResolveID for one instance of a block, keeping the resource instance an indexed reference
+// points to, e.g. `fabric_workspace.env["dev"]`. ctx is the context of the instance, see blockInstances.
+// It returns "" when the index can't be evaluated, since the instance is unknown
+func (r *referenceResolver) ResolveInstanceID(runner tflint.Runner, expr hcl.Expression, forEach hcl.Expression, ctx *hcl.EvalContext) string {
+	if expr == nil {
+		return ""
+	}
+
+	if value, ok := evaluateInstanceExpr(runner, expr, ctx); ok && value.Type() == cty.String && value.AsString() != "" {
+		return strings.ToLower(value.AsString())
+	}
+	if reference, indexed := instanceReference(runner, expr, ctx); indexed {
+		return reference
+	}
+	return r.ResolveID(runner, expr, forEach)
+}
+
+// forEachExpr returns the for_each expression of block, or nil when it has none
+// The block must have been fetched with for_each in its schema
+func forEachExpr(block *hclext.Block) hcl.Expression {
+	if attr, exists := block.Body.Attributes["for_each"]; exists {
+		return attr.Expr
+	}
+	return nil
+}
+
+// isNullAttribute reports whether attr is explicitly set to null
+func isNullAttribute(runner tflint.Runner, attr *hclext.Attribute) bool {
+	return isNullExpr(runner, attr.Expr)
+}
+
+// isNullExpr reports whether expr evaluates to null
+func isNullExpr(runner tflint.Runner, expr hcl.Expression) bool {
+	var value cty.Value
+	if err := runner.EvaluateExpr(expr, &value, nil); err != nil {
+		return false
+	}
+	return value.IsKnown() && value.IsNull()
+}
+
+// extractResourceReference extracts "fabric_workspace.example" from expressions like:
+// - fabric_workspace.example.id
+// - fabric_workspace.example[0].id
+// - fabric_workspace.example[count.index].id
+// It returns "" unless the expression refers to exactly one managed resource
+func extractResourceReference(expr hcl.Expression) string {
+	var reference string
+	for _, traversal := range expr.Variables() {
+		switch traversal.RootName() {
+		case "local", "each", "module", "data", "var", "count", "path", "terraform", "self":
+			continue
+		}
+
+		name := traversalAttrName(traversal, 1)
+		if name == "" {
+			continue
+		}
+
+		current := fmt.Sprintf("%s.%s", traversal.RootName(), name)
+		if reference != "" && reference != current {
+			return ""
+		}
+		reference = current
+	}
+	return reference
+}
+
+// traversalAttrName returns the attribute name at position index of traversal, or "" if there is none
+func traversalAttrName(traversal hcl.Traversal, index int) string {
+	if len(traversal) <= index {
+		return ""
+	}
+	if attr, ok := traversal[index].(hcl.TraverseAttr); ok {
+		return attr.Name
+	}
+	return ""
+}
+
+// attributeExprs returns the expressions of attrs
+func attributeExprs(attrs hclext.Attributes) []hcl.Expression {
+	exprs := make([]hcl.Expression, 0, len(attrs))
+	for _, attr := range attrs {
+		exprs = append(exprs, attr.Expr)
+	}
+	return exprs
+}
@@ -193,6 +193,48 @@ func TestFabricDeploymentPipelineStagesCount(t *testing.T) {
 			}`,
 			hasIssue: true,
 		},
+		{
+			name: "valid - stages attribute with 2 stages",
+			content: `resource "fabric_deployment_pipeline" "example" {
+				display_name = "Test"
+				stages = [
+					{ display_name = "dev", is_public = true },
+					{ display_name = "prod", is_public = false },
+				]
+			}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - stages attribute with 1 stage",
+			content: `resource "fabric_deployment_pipeline" "example" {
+				display_name = "Test"
+				stages = [
+					{ display_name = "prod", is_public = false },
+				]
+			}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - stages built with a for expression",
+			content: `
+variable "stage_names" {
+	default = ["dev", "test", "uat", "preprod", "prod", "dr", "sandbox", "training", "qa", "perf", "demo"]
+}
+
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Test"
+	stages       = [for name in var.stage_names : { display_name = name, is_public = false }]
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - stages not known statically",
+			content: `resource "fabric_deployment_pipeline" "example" {
+				display_name = "Test"
+				stages       = var.stages
+			}`,
+			hasIssue: false,
+		},
 	}
 
 	rule := NewFabricDeploymentPipelineStagesCount()
@@ -344,6 +386,17 @@ func TestFabricDeploymentPipelineStagesDisplayNameLength(t *testing.T) {
 			}`,
 			hasIssue: true,
 		},
+		{
+			name: "invalid - exceeds max length in stages attribute",
+			content: `resource "fabric_deployment_pipeline" "example" {
+				display_name = "Test"
+				stages = [
+					{ display_name = "` + string(make([]byte, 257)) + `", is_public = true },
+					{ display_name = "prod", is_public = true },
+				]
+			}`,
+			hasIssue: true,
+		},
 	}
 
 	rule := NewFabricDeploymentPipelineStagesDisplayNameLength()
@@ -366,6 +419,309 @@ func TestFabricDeploymentPipelineStagesDisplayNameLength(t *testing.T) {
 	}
 }
 
+// TestFabricDeploymentPipelineStagesSemantics tests stage name uniqueness, workspace assignment and stage conventions
+func TestFabricDeploymentPipelineStagesSemantics(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		hasIssue bool
+	}{
+		{
+			name: "valid - unique stages and workspaces",
+			content: `
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Sales"
+	stages = [
+		{ display_name = "Development", is_public = true, workspace_id = fabric_workspace.dev.id },
+		{ display_name = "Production", is_public = false, workspace_id = fabric_workspace.prod.id },
+	]
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - duplicate stage display names ignoring case",
+			content: `
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Sales"
+	stages = [
+		{ display_name = "Test", is_public = true },
+		{ display_name = "test", is_public = false },
+	]
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - duplicate stage display names from a for expression",
+			content: `
+variable "stages" {
+	default = ["Dev", "Test", "Dev"]
+}
+
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Sales"
+	stages       = [for name in var.stages : { display_name = name, is_public = false }]
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - same workspace in two stages",
+			content: `
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Sales"
+	stages = [
+		{ display_name = "Development", is_public = true, workspace_id = fabric_workspace.shared.id },
+		{ display_name = "Production", is_public = false, workspace_id = fabric_workspace.shared.id },
+	]
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - same workspace in two pipelines",
+			content: `
+resource "fabric_deployment_pipeline" "sales" {
+	display_name = "Sales"
+	stages = [
+		{ display_name = "Development", is_public = true, workspace_id = fabric_workspace.dev.id },
+		{ display_name = "Production", is_public = false, workspace_id = fabric_workspace.prod.id },
+	]
+}
+
+resource "fabric_deployment_pipeline" "finance" {
+	display_name = "Finance"
+	stages = [
+		{ display_name = "Development", is_public = true, workspace_id = fabric_workspace.dev.id },
+		{ display_name = "Production", is_public = false, workspace_id = fabric_workspace.finance.id },
+	]
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - same literal workspace ID in stages blocks",
+			content: `
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Sales"
+	stages {
+		display_name = "Development"
+		is_public    = true
+		workspace_id = "00000000-0000-0000-0000-00000000000A"
+	}
+	stages {
+		display_name = "Production"
+		is_public    = false
+		workspace_id = "00000000-0000-0000-0000-00000000000a"
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - same workspace through a local",
+			content: `
+locals {
+	workspace_id = fabric_workspace.shared.id
+}
+
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Sales"
+	stages = [
+		{ display_name = "Development", is_public = true, workspace_id = local.workspace_id },
+		{ display_name = "Production", is_public = false, workspace_id = fabric_workspace.shared.id },
+	]
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - instances of a for_each workspace",
+			content: `
+resource "fabric_workspace" "env" {
+	for_each     = toset(["dev", "prod"])
+	display_name = each.key
+}
+
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Sales"
+	stages = [
+		{ display_name = "Development", is_public = true, workspace_id = fabric_workspace.env["dev"].id },
+		{ display_name = "Production", is_public = false, workspace_id = fabric_workspace.env["prod"].id },
+	]
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - same instance of a for_each workspace",
+			content: `
+resource "fabric_workspace" "env" {
+	for_each     = toset(["dev", "prod"])
+	display_name = each.key
+}
+
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Sales"
+	stages = [
+		{ display_name = "Development", is_public = true, workspace_id = fabric_workspace.env["dev"].id },
+		{ display_name = "Production", is_public = false, workspace_id = fabric_workspace.env["dev"].id },
+	]
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - workspace IDs from a for expression are not compared",
+			content: `
+resource "fabric_workspace" "env" {
+	for_each     = toset(["dev", "prod"])
+	display_name = each.key
+}
+
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Sales"
+	stages = [for name in ["dev", "prod"] : {
+		display_name = name
+		is_public    = false
+		workspace_id = fabric_workspace.env[name].id
+	}]
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - public stages allowed by default",
+			content: `
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Sales"
+	stages = [
+		{ display_name = "Development", is_public = true },
+		{ display_name = "Production", is_public = true },
+	]
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - public last stage with public_stages = not_last",
+			content: `
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Sales"
+	stages = [
+		{ display_name = "Development", is_public = true },
+		{ display_name = "Production", is_public = true },
+	]
+}`,
+			config: `
+rule "fabric_deployment_pipeline_stages_semantics" {
+	enabled       = true
+	public_stages = "not_last"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - public first stage with public_stages = not_last",
+			content: `
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Sales"
+	stages = [
+		{ display_name = "Development", is_public = true },
+		{ display_name = "Production", is_public = false },
+	]
+}`,
+			config: `
+rule "fabric_deployment_pipeline_stages_semantics" {
+	enabled       = true
+	public_stages = "not_last"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - public stage with public_stages = none",
+			content: `
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Sales"
+	stages = [
+		{ display_name = "Development", is_public = true },
+		{ display_name = "Production", is_public = false },
+	]
+}`,
+			config: `
+rule "fabric_deployment_pipeline_stages_semantics" {
+	enabled       = true
+	public_stages = "none"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - last stage named as configured",
+			content: `
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Sales"
+	stages = [
+		{ display_name = "Development", is_public = true },
+		{ display_name = "production", is_public = false },
+	]
+}`,
+			config: `
+rule "fabric_deployment_pipeline_stages_semantics" {
+	enabled         = true
+	last_stage_name = "Production"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - last stage not named as configured",
+			content: `
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Sales"
+	stages = [
+		{ display_name = "Development", is_public = true },
+		{ display_name = "Prod", is_public = false },
+	]
+}`,
+			config: `
+rule "fabric_deployment_pipeline_stages_semantics" {
+	enabled         = true
+	last_stage_name = "Production"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - first stage not named as configured",
+			content: `
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Sales"
+	stages = [
+		{ display_name = "Test", is_public = true },
+		{ display_name = "Production", is_public = false },
+	]
+}`,
+			config: `
+rule "fabric_deployment_pipeline_stages_semantics" {
+	enabled          = true
+	first_stage_name = "Development"
+}`,
+			hasIssue: true,
+		},
+	}
+
+	rule := NewFabricDeploymentPipelineStagesSemantics()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": tt.content}
+			if tt.config != "" {
+				files[".tflint.hcl"] = tt.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
 // TestFabricDomainContributorsScope tests contributors scope validation
 func TestFabricDomainContributorsScope(t *testing.T) {
 	tests := []struct {
@@ -427,48 +783,144 @@ func TestFabricDomainContributorsScope(t *testing.T) {
 	}
 }
 
-// TestFabricItemDescriptionRecommended tests description recommendations
-func TestFabricItemDescriptionRecommended(t *testing.T) {
+// TestFabricDomainHierarchy tests domain hierarchy validation
+func TestFabricDomainHierarchy(t *testing.T) {
 	tests := []struct {
 		name     string
 		content  string
 		hasIssue bool
 	}{
 		{
-			name: "valid - workspace with description",
-			content: `resource "fabric_workspace" "example" {
-				display_name = "Test"
-				description = "Test workspace"
-			}`,
+			name: "valid - root domain with subdomain",
+			content: `
+resource "fabric_domain" "parent" {
+	display_name = "Parent"
+	contributors_scope = "AdminsOnly"
+}
+
+resource "fabric_domain" "child" {
+	display_name = "Child"
+	parent_domain_id = fabric_domain.parent.id
+}`,
 			hasIssue: false,
 		},
 		{
-			name: "valid - lakehouse with description",
-			content: `resource "fabric_lakehouse" "example" {
-				workspace_id = "test"
-				display_name = "Test"
-				description = "Test lakehouse"
+			name: "valid - subdomain of existing domain",
+			content: `resource "fabric_domain" "child" {
+				display_name = "Child"
+				parent_domain_id = "00000000-0000-0000-0000-000000000000"
 			}`,
 			hasIssue: false,
 		},
 		{
-			name: "warning - workspace without description",
-			content: `resource "fabric_workspace" "example" {
-				display_name = "Test"
+			name: "valid - null parent_domain_id with contributors_scope",
+			content: `resource "fabric_domain" "example" {
+				display_name = "Example"
+				parent_domain_id = null
+				contributors_scope = "AllTenant"
 			}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - nested more than one level",
+			content: `
+resource "fabric_domain" "parent" {
+	display_name = "Parent"
+}
+
+resource "fabric_domain" "child" {
+	display_name = "Child"
+	parent_domain_id = fabric_domain.parent.id
+}
+
+resource "fabric_domain" "grandchild" {
+	display_name = "Grandchild"
+	parent_domain_id = fabric_domain.child.id
+}`,
 			hasIssue: true,
 		},
 		{
-			name: "warning - empty description",
-			content: `resource "fabric_workspace" "example" {
-				display_name = "Test"
-				description = ""
-			}`,
+			name: "invalid - nested through local",
+			content: `
+resource "fabric_domain" "child" {
+	display_name = "Child"
+	parent_domain_id = "00000000-0000-0000-0000-000000000000"
+}
+
+locals {
+	child_domain_id = fabric_domain.child.id
+}
+
+resource "fabric_domain" "grandchild" {
+	display_name = "Grandchild"
+	parent_domain_id = local.child_domain_id
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - parent cycle",
+			content: `
+resource "fabric_domain" "a" {
+	display_name = "A"
+	parent_domain_id = fabric_domain.b.id
+}
+
+resource "fabric_domain" "b" {
+	display_name = "B"
+	parent_domain_id = fabric_domain.a.id
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - subdomain with contributors_scope",
+			content: `
+resource "fabric_domain" "parent" {
+	display_name = "Parent"
+}
+
+resource "fabric_domain" "child" {
+	display_name = "Child"
+	parent_domain_id = fabric_domain.parent.id
+	contributors_scope = "AllTenant"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - role assignments on root domain",
+			content: `
+resource "fabric_domain" "parent" {
+	display_name = "Parent"
+}
+
+resource "fabric_domain_role_assignments" "admins" {
+	domain_id = fabric_domain.parent.id
+	role = "Admins"
+	principals = []
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - role assignments on subdomain",
+			content: `
+resource "fabric_domain" "parent" {
+	display_name = "Parent"
+}
+
+resource "fabric_domain" "child" {
+	display_name = "Child"
+	parent_domain_id = fabric_domain.parent.id
+}
+
+resource "fabric_domain_role_assignments" "admins" {
+	domain_id = fabric_domain.child.id
+	role = "Admins"
+	principals = []
+}`,
 			hasIssue: true,
 		},
 	}
 
-	rule := NewFabricItemDescriptionRecommended()
+	rule := NewFabricDomainHierarchy()
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
@@ -488,61 +940,84 @@ func TestFabricItemDescriptionRecommended(t *testing.T) {
 	}
 }
 
-// TestFabricRoleAssignmentRecommended tests role assignment recommendations
-func TestFabricRoleAssignmentRecommended(t *testing.T) {
+// TestFabricDomainRoleAssignmentsPrincipals tests domain role assignment principals validation
+func TestFabricDomainRoleAssignmentsPrincipals(t *testing.T) {
 	tests := []struct {
 		name     string
 		content  string
 		hasIssue bool
 	}{
 		{
-			name: "valid - workspace with role assignment",
-			content: `
-resource "fabric_workspace" "example" {
-	display_name = "Test"
-}
-
-resource "fabric_workspace_role_assignment" "example" {
-	workspace_id = fabric_workspace.example.id
-	principal_id = "test-id"
-	principal_type = "User"
-	role = "Admin"
-}`,
+			name: "valid principals",
+			content: `resource "fabric_domain_role_assignments" "example" {
+				domain_id = fabric_domain.example.id
+				role = "Admins"
+				principals = [
+					{ id = "00000000-0000-0000-0000-000000000001", type = "User" },
+					{ id = "00000000-0000-0000-0000-000000000002", type = "Group" },
+				]
+			}`,
 			hasIssue: false,
 		},
 		{
-			name: "warning - workspace without role assignment",
-			content: `resource "fabric_workspace" "example" {
-				display_name = "Test"
+			name: "valid - unknown principal id is skipped",
+			content: `resource "fabric_domain_role_assignments" "example" {
+				domain_id = fabric_domain.example.id
+				role = "Contributors"
+				principals = [
+					{ id = azuread_group.example.object_id, type = "Group" },
+				]
+			}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - id is not a UUID",
+			content: `resource "fabric_domain_role_assignments" "example" {
+				domain_id = fabric_domain.example.id
+				role = "Admins"
+				principals = [
+					{ id = "not-a-uuid", type = "User" },
+				]
 			}`,
 			hasIssue: true,
 		},
 		{
-			name: "valid - deployment pipeline with role assignment",
-			content: `
-resource "fabric_deployment_pipeline" "example" {
-	display_name = "Test"
-	stages {
-		display_name = "dev"
-		is_public = true
-	}
-	stages {
-		display_name = "prod"
-		is_public = true
-	}
-}
-
-resource "fabric_deployment_pipeline_role_assignment" "example" {
-	deployment_pipeline_id = fabric_deployment_pipeline.example.id
-	principal_id = "test-id"
-	principal_type = "User"
-	role = "Admin"
-}`,
-			hasIssue: false,
+			name: "invalid - unsupported principal type",
+			content: `resource "fabric_domain_role_assignments" "example" {
+				domain_id = fabric_domain.example.id
+				role = "Admins"
+				principals = [
+					{ id = "00000000-0000-0000-0000-000000000001", type = "ServicePrincipal" },
+				]
+			}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - missing type",
+			content: `resource "fabric_domain_role_assignments" "example" {
+				domain_id = fabric_domain.example.id
+				role = "Admins"
+				principals = [
+					{ id = "00000000-0000-0000-0000-000000000001" },
+				]
+			}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - duplicate principal",
+			content: `resource "fabric_domain_role_assignments" "example" {
+				domain_id = fabric_domain.example.id
+				role = "Admins"
+				principals = [
+					{ id = "00000000-0000-0000-0000-000000000001", type = "User" },
+					{ id = "00000000-0000-0000-0000-000000000001", type = "User" },
+				]
+			}`,
+			hasIssue: true,
 		},
 	}
 
-	rule := NewFabricRoleAssignmentRecommended()
+	rule := NewFabricDomainRoleAssignmentsPrincipals()
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
@@ -562,34 +1037,262 @@ resource "fabric_deployment_pipeline_role_assignment" "example" {
 	}
 }
 
-// TestFabricWorkspaceCapacity tests capacity requirement
-func TestFabricWorkspaceCapacity(t *testing.T) {
+// TestFabricDomainWorkspaceAssignmentsConflict tests domain workspace assignment conflict detection
+func TestFabricDomainWorkspaceAssignmentsConflict(t *testing.T) {
 	tests := []struct {
 		name     string
 		content  string
+		config   string
 		hasIssue bool
 	}{
 		{
-			name: "valid - with capacity",
-			content: `resource "fabric_workspace" "example" {
-				display_name = "Test"
-				capacity_id = "test-capacity-id"
-			}`,
+			name: "valid - workspaces assigned to different domains",
+			content: `
+resource "fabric_workspace" "sales" {
+	display_name = "Sales"
+}
+
+resource "fabric_workspace" "finance" {
+	display_name = "Finance"
+}
+
+resource "fabric_domain_workspace_assignments" "sales" {
+	domain_id = fabric_domain.sales.id
+	workspace_ids = [fabric_workspace.sales.id]
+}
+
+resource "fabric_domain_workspace_assignments" "finance" {
+	domain_id = fabric_domain.finance.id
+	workspace_ids = toset([fabric_workspace.finance.id])
+}`,
 			hasIssue: false,
 		},
 		{
-			name: "error - without capacity",
-			content: `resource "fabric_workspace" "example" {
-				display_name = "Test"
-			}`,
+			name: "valid - different instances of a for_each domain",
+			content: `
+resource "fabric_workspace" "sales" {
+	display_name = "Sales"
+}
+
+resource "fabric_workspace" "finance" {
+	display_name = "Finance"
+}
+
+resource "fabric_domain_workspace_assignments" "a" {
+	domain_id = fabric_domain.d["a"].id
+	workspace_ids = [fabric_workspace.sales.id]
+}
+
+resource "fabric_domain_workspace_assignments" "b" {
+	domain_id = fabric_domain.d["b"].id
+	workspace_ids = [fabric_workspace.finance.id]
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - same instance of a for_each domain",
+			content: `
+resource "fabric_workspace" "sales" {
+	display_name = "Sales"
+}
+
+resource "fabric_workspace" "finance" {
+	display_name = "Finance"
+}
+
+resource "fabric_domain_workspace_assignments" "a" {
+	domain_id = fabric_domain.d["a"].id
+	workspace_ids = [fabric_workspace.sales.id]
+}
+
+resource "fabric_domain_workspace_assignments" "b" {
+	domain_id = fabric_domain.d["a"].id
+	workspace_ids = [fabric_workspace.finance.id]
+}`,
 			hasIssue: true,
 		},
+		{
+			name: "valid - different instances of a for_each workspace in two domains",
+			content: `
+resource "fabric_workspace" "ws" {
+	for_each = {
+		x = "X"
+		y = "Y"
 	}
+	display_name = each.value
+}
 
-	rule := NewFabricWorkspaceCapacity()
+resource "fabric_domain_workspace_assignments" "sales" {
+	domain_id = fabric_domain.sales.id
+	workspace_ids = [fabric_workspace.ws["x"].id]
+}
+
+resource "fabric_domain_workspace_assignments" "finance" {
+	domain_id = fabric_domain.finance.id
+	workspace_ids = [fabric_workspace.ws["y"].id]
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - same instance of a for_each workspace in two domains",
+			content: `
+resource "fabric_workspace" "ws" {
+	for_each = {
+		x = "X"
+		y = "Y"
+	}
+	display_name = each.value
+}
+
+resource "fabric_domain_workspace_assignments" "sales" {
+	domain_id = fabric_domain.sales.id
+	workspace_ids = [fabric_workspace.ws["x"].id]
+}
+
+resource "fabric_domain_workspace_assignments" "finance" {
+	domain_id = fabric_domain.finance.id
+	workspace_ids = [fabric_workspace.ws["x"].id]
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - domain and workspaces indexed with each.key are skipped",
+			content: `
+resource "fabric_workspace" "ws" {
+	for_each = {
+		x = "X"
+		y = "Y"
+	}
+	display_name = each.value
+}
+
+resource "fabric_domain_workspace_assignments" "env" {
+	for_each = {
+		x = "X"
+		y = "Y"
+	}
+	domain_id = fabric_domain.d[each.key].id
+	workspace_ids = [fabric_workspace.ws[each.key].id]
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - workspace assigned to two domains",
+			content: `
+resource "fabric_workspace" "shared" {
+	display_name = "Shared"
+}
+
+resource "fabric_domain_workspace_assignments" "sales" {
+	domain_id = fabric_domain.sales.id
+	workspace_ids = [fabric_workspace.shared.id]
+}
+
+resource "fabric_domain_workspace_assignments" "finance" {
+	domain_id = fabric_domain.finance.id
+	workspace_ids = [fabric_workspace.shared.id]
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - workspace assigned to two domains through local",
+			content: `
+resource "fabric_workspace" "shared" {
+	display_name = "Shared"
+}
+
+locals {
+	shared_workspace_ids = [fabric_workspace.shared.id]
+}
+
+resource "fabric_domain_workspace_assignments" "sales" {
+	domain_id = fabric_domain.sales.id
+	workspace_ids = local.shared_workspace_ids
+}
+
+resource "fabric_domain_workspace_assignments" "finance" {
+	domain_id = fabric_domain.finance.id
+	workspace_ids = [fabric_workspace.shared.id]
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - two assignment resources for the same domain",
+			content: `
+resource "fabric_workspace" "a" {
+	display_name = "A"
+}
+
+resource "fabric_workspace" "b" {
+	display_name = "B"
+}
+
+resource "fabric_domain_workspace_assignments" "first" {
+	domain_id = fabric_domain.sales.id
+	workspace_ids = [fabric_workspace.a.id]
+}
+
+resource "fabric_domain_workspace_assignments" "second" {
+	domain_id = fabric_domain.sales.id
+	workspace_ids = [fabric_workspace.b.id]
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - workspace not declared",
+			content: `
+resource "fabric_domain_workspace_assignments" "sales" {
+	domain_id = fabric_domain.sales.id
+	workspace_ids = [fabric_workspace.missing.id]
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - literal workspace ID not in allowlist",
+			content: `
+resource "fabric_domain_workspace_assignments" "sales" {
+	domain_id = fabric_domain.sales.id
+	workspace_ids = ["00000000-0000-0000-0000-000000000001"]
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - literal workspace ID in allowlist",
+			content: `
+resource "fabric_domain_workspace_assignments" "sales" {
+	domain_id = fabric_domain.sales.id
+	workspace_ids = ["00000000-0000-0000-0000-000000000001"]
+}`,
+			config: `
+rule "fabric_domain_workspace_assignments_conflict" {
+	enabled = true
+	external_workspace_ids = ["00000000-0000-0000-0000-000000000001"]
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - workspace looked up with data source",
+			content: `
+data "fabric_workspace" "existing" {
+	display_name = "Existing"
+}
+
+resource "fabric_domain_workspace_assignments" "sales" {
+	domain_id = fabric_domain.sales.id
+	workspace_ids = [data.fabric_workspace.existing.id]
+}`,
+			hasIssue: false,
+		},
+	}
+
+	rule := NewFabricDomainWorkspaceAssignmentsConflict()
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			files := map[string]string{"main.tf": tt.content}
+			if tt.config != "" {
+				files[".tflint.hcl"] = tt.config
+			}
+			runner := helper.TestRunner(t, files)
 			if err := rule.Check(runner); err != nil {
 				t.Fatalf("Unexpected error: %s", err)
 			}
@@ -606,76 +1309,78 @@ func TestFabricWorkspaceCapacity(t *testing.T) {
 	}
 }
 
-// TestFabricWorkspaceGitAzdoAttributes tests Azure DevOps git attributes
-func TestFabricWorkspaceGitAzdoAttributes(t *testing.T) {
+// TestFabricEventhouseMinimumConsumptionUnits tests minimum consumption units validation
+func TestFabricEventhouseMinimumConsumptionUnits(t *testing.T) {
 	tests := []struct {
 		name     string
 		content  string
 		hasIssue bool
 	}{
 		{
-			name: "valid - Azure DevOps with all required attributes",
-			content: `resource "fabric_workspace_git" "example" {
-				workspace_id = "test"
-				initialization_strategy = "PreferWorkspace"
-				git_provider_details {
-					git_provider_type = "AzureDevOps"
-					organization_name = "myorg"
-					project_name = "myproject"
-					repository_name = "myrepo"
-					branch_name = "main"
-					directory_name = "/test"
-				}
-			}`,
+			name: "valid - fixed value",
+			content: `
+resource "fabric_eventhouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "telemetry"
+	configuration = {
+		minimum_consumption_units = 2.25
+	}
+}`,
 			hasIssue: false,
 		},
 		{
-			name: "error - Azure DevOps missing organization_name",
-			content: `resource "fabric_workspace_git" "example" {
-				workspace_id = "test"
-				initialization_strategy = "PreferWorkspace"
-				git_provider_details {
-					git_provider_type = "AzureDevOps"
-					project_name = "myproject"
-					repository_name = "myrepo"
-					branch_name = "main"
-					directory_name = "/test"
-				}
-			}`,
+			name: "valid - value in range as a block",
+			content: `
+resource "fabric_eventhouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "telemetry"
+
+	configuration {
+		minimum_consumption_units = 100
+	}
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - value between fixed values",
+			content: `
+resource "fabric_eventhouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "telemetry"
+	configuration = {
+		minimum_consumption_units = 10
+	}
+}`,
 			hasIssue: true,
 		},
 		{
-			name: "error - Azure DevOps missing project_name",
-			content: `resource "fabric_workspace_git" "example" {
-				workspace_id = "test"
-				initialization_strategy = "PreferWorkspace"
-				git_provider_details {
-					git_provider_type = "AzureDevOps"
-					organization_name = "myorg"
-					repository_name = "myrepo"
-					branch_name = "main"
-					directory_name = "/test"
-				}
-			}`,
+			name: "invalid - value above range",
+			content: `
+resource "fabric_eventhouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "telemetry"
+	configuration = {
+		minimum_consumption_units = 400
+	}
+}`,
 			hasIssue: true,
 		},
 		{
-			name: "valid - GitHub (should not check Azure DevOps attributes)",
-			content: `resource "fabric_workspace_git" "example" {
-				workspace_id = "test"
-				initialization_strategy = "PreferWorkspace"
-				git_provider_details {
-					git_provider_type = "GitHub"
-					repository_name = "myrepo"
-					branch_name = "main"
-					directory_name = "/test"
-				}
-			}`,
+			name: "valid - value from a variable",
+			content: `
+resource "fabric_eventhouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "telemetry"
+	configuration = {
+		minimum_consumption_units = var.minimum_consumption_units
+	}
+}`,
 			hasIssue: false,
 		},
 	}
 
-	rule := NewFabricWorkspaceGitAzureDevOpsAttributes()
+	rule := NewFabricEventhouseMinimumConsumptionUnits()
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
@@ -695,21 +1400,2384 @@ func TestFabricWorkspaceGitAzdoAttributes(t *testing.T) {
 	}
 }
 
-// TestFabricWorkspaceGitCredentialsSource tests git credentials source validation
-func TestFabricWorkspaceGitCredentialsSource(t *testing.T) {
+// TestFabricFolderHierarchy tests folder tree validation
+func TestFabricFolderHierarchy(t *testing.T) {
 	tests := []struct {
 		name     string
 		content  string
+		config   string
 		hasIssue bool
 	}{
 		{
-			name: "valid - GitHub with ConfiguredConnection",
-			content: `resource "fabric_workspace_git" "example" {
+			name: "valid - nested folders",
+			content: `
+resource "fabric_folder" "reports" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Reports"
+}
+
+resource "fabric_folder" "sales" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Sales"
+	parent_folder_id = fabric_folder.reports.id
+}
+
+resource "fabric_folder" "finance" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Finance"
+	parent_folder_id = fabric_folder.reports.id
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - same display name under different parents",
+			content: `
+resource "fabric_folder" "sales" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Sales"
+}
+
+resource "fabric_folder" "finance" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Finance"
+}
+
+resource "fabric_folder" "sales_archive" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Archive"
+	parent_folder_id = fabric_folder.sales.id
+}
+
+resource "fabric_folder" "finance_archive" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Archive"
+	parent_folder_id = fabric_folder.finance.id
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - same display name under parents that can't be resolved",
+			content: `
+data "fabric_folder" "sales" {
+	workspace_id = "00000000-0000-0000-0000-000000000001"
+	display_name = "Sales"
+}
+
+data "fabric_folder" "finance" {
+	workspace_id = "00000000-0000-0000-0000-000000000001"
+	display_name = "Finance"
+}
+
+resource "fabric_folder" "archive" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Archive"
+}
+
+resource "fabric_folder" "sales_archive" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Archive"
+	parent_folder_id = data.fabric_folder.sales.id
+}
+
+resource "fabric_folder" "finance_archive" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Archive"
+	parent_folder_id = data.fabric_folder.finance.id
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - duplicate sibling display names",
+			content: `
+resource "fabric_folder" "reports" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Reports"
+}
+
+resource "fabric_folder" "reports_copy" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "reports"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - parent in another workspace",
+			content: `
+resource "fabric_folder" "reports" {
+	workspace_id = fabric_workspace.a.id
+	display_name = "Reports"
+}
+
+resource "fabric_folder" "sales" {
+	workspace_id = fabric_workspace.b.id
+	display_name = "Sales"
+	parent_folder_id = fabric_folder.reports.id
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - parent cycle",
+			content: `
+resource "fabric_folder" "a" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "A"
+	parent_folder_id = fabric_folder.b.id
+}
+
+resource "fabric_folder" "b" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "B"
+	parent_folder_id = fabric_folder.a.id
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - nested deeper than max_depth",
+			content: `
+resource "fabric_folder" "a" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "A"
+}
+
+resource "fabric_folder" "b" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "B"
+	parent_folder_id = fabric_folder.a.id
+}
+
+resource "fabric_folder" "c" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "C"
+	parent_folder_id = fabric_folder.b.id
+}`,
+			config: `
+rule "fabric_folder_hierarchy" {
+	enabled = true
+	max_depth = 2
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - nested deeper than max_depth below existing folder",
+			content: `
+resource "fabric_folder" "a" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "A"
+	parent_folder_id = "00000000-0000-0000-0000-000000000000"
+}
+
+resource "fabric_folder" "b" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "B"
+	parent_folder_id = fabric_folder.a.id
+}`,
+			config: `
+rule "fabric_folder_hierarchy" {
+	enabled = true
+	max_depth = 2
+}`,
+			hasIssue: true,
+		},
+	}
+
+	rule := NewFabricFolderHierarchy()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": tt.content}
+			if tt.config != "" {
+				files[".tflint.hcl"] = tt.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricGatewayTypeAttributes tests gateway attributes per gateway type
+func TestFabricGatewayTypeAttributes(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hasIssue bool
+	}{
+		{
+			name: "valid - virtual network gateway",
+			content: `
+resource "fabric_gateway" "example" {
+	type                            = "VirtualNetwork"
+	display_name                    = "vnet-gateway"
+	capacity_id                     = "00000000-0000-0000-0000-000000000000"
+	inactivity_minutes_before_sleep = 30
+	number_of_member_gateways       = 1
+	virtual_network_azure_resource = {
+		subscription_id      = "00000000-0000-0000-0000-000000000000"
+		resource_group_name  = "rg-network"
+		virtual_network_name = "vnet-fabric"
+		subnet_name          = "snet-gateway"
+	}
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - virtual network resource as a block",
+			content: `
+resource "fabric_gateway" "example" {
+	type                            = "VirtualNetwork"
+	display_name                    = "vnet-gateway"
+	capacity_id                     = "00000000-0000-0000-0000-000000000000"
+	inactivity_minutes_before_sleep = 30
+	number_of_member_gateways       = 1
+
+	virtual_network_azure_resource {
+		subscription_id      = "00000000-0000-0000-0000-000000000000"
+		resource_group_name  = "rg-network"
+		virtual_network_name = "vnet-fabric"
+		subnet_name          = "snet-gateway"
+	}
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - virtual network resource from a variable",
+			content: `
+resource "fabric_gateway" "example" {
+	type                            = "VirtualNetwork"
+	display_name                    = "vnet-gateway"
+	capacity_id                     = var.capacity_id
+	inactivity_minutes_before_sleep = 30
+	number_of_member_gateways       = 1
+	virtual_network_azure_resource  = var.virtual_network
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - virtual network gateway without capacity",
+			content: `
+resource "fabric_gateway" "example" {
+	type                            = "VirtualNetwork"
+	display_name                    = "vnet-gateway"
+	inactivity_minutes_before_sleep = 30
+	number_of_member_gateways       = 1
+	virtual_network_azure_resource  = var.virtual_network
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - virtual network gateway with null virtual network resource",
+			content: `
+resource "fabric_gateway" "example" {
+	type                            = "VirtualNetwork"
+	display_name                    = "vnet-gateway"
+	capacity_id                     = var.capacity_id
+	inactivity_minutes_before_sleep = 30
+	number_of_member_gateways       = 1
+	virtual_network_azure_resource  = null
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - on-premises attribute on a virtual network gateway",
+			content: `
+resource "fabric_gateway" "example" {
+	type                            = "VirtualNetwork"
+	display_name                    = "vnet-gateway"
+	capacity_id                     = var.capacity_id
+	inactivity_minutes_before_sleep = 30
+	number_of_member_gateways       = 1
+	virtual_network_azure_resource  = var.virtual_network
+	load_balancing_setting          = "Failover"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - on-premises gateway is left to fabric_gateway_invalid_type",
+			content: `
+resource "fabric_gateway" "example" {
+	type         = "OnPremises"
+	display_name = "onprem-gateway"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - subscription ID is not a GUID",
+			content: `
+resource "fabric_gateway" "example" {
+	type                            = "VirtualNetwork"
+	display_name                    = "vnet-gateway"
+	capacity_id                     = var.capacity_id
+	inactivity_minutes_before_sleep = 30
+	number_of_member_gateways       = 1
+	virtual_network_azure_resource = {
+		subscription_id      = "production"
+		resource_group_name  = "rg-network"
+		virtual_network_name = "vnet-fabric"
+		subnet_name          = "snet-gateway"
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - resource group name ends with a period",
+			content: `
+resource "fabric_gateway" "example" {
+	type                            = "VirtualNetwork"
+	display_name                    = "vnet-gateway"
+	capacity_id                     = var.capacity_id
+	inactivity_minutes_before_sleep = 30
+	number_of_member_gateways       = 1
+	virtual_network_azure_resource = {
+		subscription_id      = "00000000-0000-0000-0000-000000000000"
+		resource_group_name  = "rg-network."
+		virtual_network_name = "vnet-fabric"
+		subnet_name          = "snet-gateway"
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - subnet name ends with a hyphen",
+			content: `
+resource "fabric_gateway" "example" {
+	type                            = "VirtualNetwork"
+	display_name                    = "vnet-gateway"
+	capacity_id                     = var.capacity_id
+	inactivity_minutes_before_sleep = 30
+	number_of_member_gateways       = 1
+
+	virtual_network_azure_resource {
+		subscription_id      = "00000000-0000-0000-0000-000000000000"
+		resource_group_name  = "rg-network"
+		virtual_network_name = "vnet-fabric"
+		subnet_name          = "snet-"
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - virtual network name missing",
+			content: `
+resource "fabric_gateway" "example" {
+	type                            = "VirtualNetwork"
+	display_name                    = "vnet-gateway"
+	capacity_id                     = var.capacity_id
+	inactivity_minutes_before_sleep = 30
+	number_of_member_gateways       = 1
+	virtual_network_azure_resource = {
+		subscription_id     = "00000000-0000-0000-0000-000000000000"
+		resource_group_name = "rg-network"
+		subnet_name         = "snet-gateway"
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - type from a variable",
+			content: `
+resource "fabric_gateway" "example" {
+	type         = var.gateway_type
+	display_name = "gateway"
+}`,
+			hasIssue: false,
+		},
+	}
+
+	rule := NewFabricGatewayTypeAttributes()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricItemConfigurationLifecycle tests lifecycle protection of create-only item configuration
+func TestFabricItemConfigurationLifecycle(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hasIssue bool
+	}{
+		{
+			name: "invalid - lakehouse configuration without lifecycle",
+			content: `
+resource "fabric_lakehouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales"
+	configuration = {
+		enable_schemas = true
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - warehouse configuration block without lifecycle",
+			content: `
+resource "fabric_warehouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales"
+
+	configuration {
+		collation_type = "Latin1_General_100_CI_AS_KS_WS_SC_UTF8"
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - prevent_destroy",
+			content: `
+resource "fabric_lakehouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales"
+	configuration = {
+		enable_schemas = true
+	}
+
+	lifecycle {
+		prevent_destroy = true
+	}
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - prevent_destroy disabled",
+			content: `
+resource "fabric_lakehouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales"
+	configuration = {
+		enable_schemas = true
+	}
+
+	lifecycle {
+		prevent_destroy = false
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - configuration changes ignored",
+			content: `
+resource "fabric_warehouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales"
+	configuration = {
+		collation_type = "Latin1_General_100_BIN2_UTF8"
+	}
+
+	lifecycle {
+		ignore_changes = [description, configuration.collation_type]
+	}
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - all changes ignored",
+			content: `
+resource "fabric_warehouse_snapshot" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales-snapshot"
+	configuration = {
+		parent_warehouse_id = fabric_warehouse.example.id
+	}
+
+	lifecycle {
+		ignore_changes = all
+	}
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - other changes ignored",
+			content: `
+resource "fabric_lakehouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales"
+	configuration = {
+		enable_schemas = true
+	}
+
+	lifecycle {
+		ignore_changes = [description]
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - no configuration",
+			content: `
+resource "fabric_lakehouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales"
+}`,
+			hasIssue: false,
+		},
+	}
+
+	rule := NewFabricItemConfigurationLifecycle()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricItemDescriptionRecommended tests description recommendations
+func TestFabricItemDescriptionRecommended(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hasIssue bool
+	}{
+		{
+			name: "valid - workspace with description",
+			content: `resource "fabric_workspace" "example" {
+				display_name = "Test"
+				description = "Test workspace"
+			}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - lakehouse with description",
+			content: `resource "fabric_lakehouse" "example" {
+				workspace_id = "test"
+				display_name = "Test"
+				description = "Test lakehouse"
+			}`,
+			hasIssue: false,
+		},
+		{
+			name: "warning - workspace without description",
+			content: `resource "fabric_workspace" "example" {
+				display_name = "Test"
+			}`,
+			hasIssue: true,
+		},
+		{
+			name: "warning - empty description",
+			content: `resource "fabric_workspace" "example" {
+				display_name = "Test"
+				description = ""
+			}`,
+			hasIssue: true,
+		},
+	}
+
+	rule := NewFabricItemDescriptionRecommended()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricItemDisplayNameUnique tests display name uniqueness per workspace and item type
+func TestFabricItemDisplayNameUnique(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hasIssue bool
+	}{
+		{
+			name: "valid - different display names",
+			content: `
+resource "fabric_notebook" "a" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Ingest"
+}
+
+resource "fabric_notebook" "b" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Transform"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - same display name in different workspaces",
+			content: `
+resource "fabric_notebook" "a" {
+	workspace_id = fabric_workspace.dev.id
+	display_name = "Ingest"
+}
+
+resource "fabric_notebook" "b" {
+	workspace_id = fabric_workspace.prod.id
+	display_name = "Ingest"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - same display name for different item types",
+			content: `
+resource "fabric_notebook" "ingest" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Ingest"
+}
+
+resource "fabric_data_pipeline" "ingest" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Ingest"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - same display name ignoring case",
+			content: `
+resource "fabric_notebook" "a" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Ingest"
+}
+
+resource "fabric_notebook" "b" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "INGEST"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - same literal workspace ID",
+			content: `
+resource "fabric_notebook" "a" {
+	workspace_id = "00000000-0000-0000-0000-000000000000"
+	display_name = "Ingest"
+}
+
+resource "fabric_notebook" "b" {
+	workspace_id = "00000000-0000-0000-0000-000000000000"
+	display_name = "Ingest"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - lakehouse and warehouse share SQL endpoint names",
+			content: `
+resource "fabric_lakehouse" "sales" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Sales"
+}
+
+resource "fabric_warehouse" "sales" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - for_each items with the same display name in different workspace instances",
+			content: `
+resource "fabric_workspace" "env" {
+	for_each = {
+		dev  = "Dev"
+		prod = "Prod"
+	}
+	display_name = each.value
+}
+
+resource "fabric_lakehouse" "sales" {
+	for_each = {
+		dev  = "Dev"
+		prod = "Prod"
+	}
+	workspace_id = fabric_workspace.env[each.key].id
+	display_name = "Sales"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - for_each items with the same display name in the same workspace instance",
+			content: `
+resource "fabric_lakehouse" "sales" {
+	for_each = {
+		eu = "EU"
+		us = "US"
+	}
+	workspace_id = fabric_workspace.env["prod"].id
+	display_name = "Sales"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - count items with display names built from count.index",
+			content: `
+resource "fabric_notebook" "etl" {
+	count = 2
+	workspace_id = fabric_workspace.example.id
+	display_name = "ETL ${count.index}"
+}`,
+			hasIssue: false,
+		},
+	}
+
+	rule := NewFabricItemDisplayNameUnique()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricItemWorkspaceCapacity tests detection of items in workspaces without a capacity
+func TestFabricItemWorkspaceCapacity(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hasIssue bool
+	}{
+		{
+			name: "valid - workspace with capacity",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+	capacity_id  = "00000000-0000-0000-0000-000000000000"
+}
+
+resource "fabric_lakehouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Bronze"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - capacity from data source",
+			content: `
+data "fabric_capacity" "example" {
+	display_name = "F64"
+}
+
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+	capacity_id  = data.fabric_capacity.example.id
+}
+
+resource "fabric_notebook" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Ingest"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - workspace without capacity",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+}
+
+resource "fabric_lakehouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Bronze"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - capacity_id set to null",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+	capacity_id  = null
+}
+
+resource "fabric_warehouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Sales"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - workspace without capacity through local",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+}
+
+locals {
+	workspace_id = fabric_workspace.example.id
+}
+
+resource "fabric_eventhouse" "example" {
+	workspace_id = local.workspace_id
+	display_name = "Telemetry"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - workspace without capacity through for_each",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+}
+
+resource "fabric_notebook" "example" {
+	for_each     = { ingest = fabric_workspace.example.id }
+	workspace_id = each.value
+	display_name = each.key
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - report in workspace without capacity",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Reporting"
+}
+
+resource "fabric_report" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "Sales"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - workspace from data source is unknown",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+}
+
+data "fabric_workspace" "existing" {
+	display_name = fabric_workspace.example.display_name
+}
+
+resource "fabric_lakehouse" "example" {
+	workspace_id = data.fabric_workspace.existing.id
+	display_name = "Bronze"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - workspace from module output is unknown",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+}
+
+module "platform" {
+	source       = "./platform"
+	workspace_id = fabric_workspace.example.id
+}
+
+resource "fabric_lakehouse" "example" {
+	workspace_id = module.platform.workspace_id
+	display_name = "Bronze"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - workspace not declared in this module",
+			content: `
+resource "fabric_lakehouse" "example" {
+	workspace_id = fabric_workspace.shared.id
+	display_name = "Bronze"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - conditional with a workspace that has capacity",
+			content: `
+resource "fabric_workspace" "dev" {
+	display_name = "Dev"
+}
+
+resource "fabric_workspace" "prod" {
+	display_name = "Prod"
+	capacity_id  = var.capacity_id
+}
+
+resource "fabric_lakehouse" "example" {
+	workspace_id = var.production ? fabric_workspace.prod.id : fabric_workspace.dev.id
+	display_name = "Bronze"
+}`,
+			hasIssue: false,
+		},
+	}
+
+	rule := NewFabricItemWorkspaceCapacity()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricKQLDatabaseConfiguration tests KQL database configuration per database type
+func TestFabricKQLDatabaseConfiguration(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hasIssue bool
+	}{
+		{
+			name: "valid - read write database",
+			content: `
+resource "fabric_eventhouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "telemetry"
+}
+
+resource "fabric_kql_database" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "events"
+	configuration = {
+		database_type = "ReadWrite"
+		eventhouse_id = fabric_eventhouse.example.id
+	}
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - source database on a read write database",
+			content: `
+resource "fabric_kql_database" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "events"
+	configuration = {
+		database_type        = "ReadWrite"
+		eventhouse_id        = fabric_eventhouse.example.id
+		source_database_name = "events"
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - shortcut with source cluster as a block",
+			content: `
+resource "fabric_kql_database" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "events"
+
+	configuration {
+		database_type        = "Shortcut"
+		eventhouse_id        = fabric_eventhouse.example.id
+		source_cluster_uri   = "https://adx-prod.westeurope.kusto.windows.net"
+		source_database_name = "events"
+	}
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - shortcut with invitation token",
+			content: `
+resource "fabric_kql_database" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "events"
+	configuration = {
+		database_type    = "Shortcut"
+		eventhouse_id    = fabric_eventhouse.example.id
+		invitation_token = var.invitation_token
+	}
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - shortcut without source",
+			content: `
+resource "fabric_kql_database" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "events"
+	configuration = {
+		database_type = "Shortcut"
+		eventhouse_id = fabric_eventhouse.example.id
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - shortcut without source database name",
+			content: `
+resource "fabric_kql_database" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "events"
+	configuration = {
+		database_type      = "Shortcut"
+		eventhouse_id      = fabric_eventhouse.example.id
+		source_cluster_uri = "https://adx-prod.westeurope.kusto.windows.net"
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - both invitation tokens",
+			content: `
+resource "fabric_kql_database" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "events"
+	configuration = {
+		database_type       = "Shortcut"
+		eventhouse_id       = fabric_eventhouse.example.id
+		invitation_token    = var.invitation_token
+		invitation_token_wo = var.invitation_token
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - source cluster URI is not https",
+			content: `
+resource "fabric_kql_database" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "events"
+	configuration = {
+		database_type        = "Shortcut"
+		eventhouse_id        = fabric_eventhouse.example.id
+		source_cluster_uri   = "http://adx-prod.westeurope.kusto.windows.net"
+		source_database_name = "events"
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - source cluster URI with a database path",
+			content: `
+resource "fabric_kql_database" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "events"
+	configuration = {
+		database_type        = "Shortcut"
+		eventhouse_id        = fabric_eventhouse.example.id
+		source_cluster_uri   = "https://adx-prod.westeurope.kusto.windows.net/events"
+		source_database_name = "events"
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - eventhouse_id refers to a lakehouse",
+			content: `
+resource "fabric_kql_database" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "events"
+	configuration = {
+		database_type = "ReadWrite"
+		eventhouse_id = fabric_lakehouse.example.id
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - eventhouse in another workspace",
+			content: `
+resource "fabric_eventhouse" "example" {
+	workspace_id = fabric_workspace.dev.id
+	display_name = "telemetry"
+}
+
+resource "fabric_kql_database" "example" {
+	workspace_id = fabric_workspace.prod.id
+	display_name = "events"
+	configuration = {
+		database_type = "ReadWrite"
+		eventhouse_id = fabric_eventhouse.example.id
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - workspace taken from the eventhouse",
+			content: `
+resource "fabric_eventhouse" "example" {
+	workspace_id = fabric_workspace.dev.id
+	display_name = "telemetry"
+}
+
+resource "fabric_kql_database" "example" {
+	workspace_id = fabric_eventhouse.example.workspace_id
+	display_name = "events"
+	configuration = {
+		database_type = "ReadWrite"
+		eventhouse_id = fabric_eventhouse.example.id
+	}
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - eventhouse and database per environment",
+			content: `
+locals {
+	workspaces = {
+		dev  = fabric_workspace.dev.id
+		prod = fabric_workspace.prod.id
+	}
+}
+
+resource "fabric_eventhouse" "example" {
+	for_each     = local.workspaces
+	workspace_id = each.value
+	display_name = "telemetry"
+}
+
+resource "fabric_kql_database" "example" {
+	for_each     = local.workspaces
+	workspace_id = each.value
+	display_name = "events"
+	configuration = {
+		database_type = "ReadWrite"
+		eventhouse_id = fabric_eventhouse.example[each.key].id
+	}
+}`,
+			hasIssue: false,
+		},
+	}
+
+	rule := NewFabricKQLDatabaseConfiguration()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricRoleAssignmentDuplicate tests duplicate and conflicting role assignment detection
+func TestFabricRoleAssignmentDuplicate(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hasIssue bool
+	}{
+		{
+			name: "valid - different principals on same workspace",
+			content: `
+resource "fabric_workspace_role_assignment" "admin" {
+	workspace_id = fabric_workspace.example.id
+	principal = { id = "00000000-0000-0000-0000-000000000001", type = "User" }
+	role = "Admin"
+}
+
+resource "fabric_workspace_role_assignment" "viewer" {
+	workspace_id = fabric_workspace.example.id
+	principal = { id = "00000000-0000-0000-0000-000000000002", type = "Group" }
+	role = "Viewer"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - same principal on different workspaces",
+			content: `
+resource "fabric_workspace_role_assignment" "dev" {
+	workspace_id = fabric_workspace.dev.id
+	principal = { id = "00000000-0000-0000-0000-000000000001", type = "User" }
+	role = "Admin"
+}
+
+resource "fabric_workspace_role_assignment" "prod" {
+	workspace_id = fabric_workspace.prod.id
+	principal = { id = "00000000-0000-0000-0000-000000000001", type = "User" }
+	role = "Admin"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "duplicate - same principal and role on same workspace",
+			content: `
+resource "fabric_workspace_role_assignment" "first" {
+	workspace_id = fabric_workspace.example.id
+	principal = { id = "00000000-0000-0000-0000-000000000001", type = "User" }
+	role = "Admin"
+}
+
+resource "fabric_workspace_role_assignment" "second" {
+	workspace_id = fabric_workspace.example.id
+	principal = { id = "00000000-0000-0000-0000-000000000001", type = "User" }
+	role = "Admin"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "conflict - same principal with different roles via variable",
+			content: `
+variable "principal_id" {
+	default = "00000000-0000-0000-0000-000000000001"
+}
+
+resource "fabric_workspace_role_assignment" "admin" {
+	workspace_id = "11111111-1111-1111-1111-111111111111"
+	principal = { id = var.principal_id, type = "User" }
+	role = "Admin"
+}
+
+resource "fabric_workspace_role_assignment" "viewer" {
+	workspace_id = "11111111-1111-1111-1111-111111111111"
+	principal = { id = var.principal_id, type = "User" }
+	role = "Viewer"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "duplicate - gateway role assignment",
+			content: `
+resource "fabric_gateway_role_assignment" "first" {
+	gateway_id = fabric_gateway.example.id
+	principal = { id = "00000000-0000-0000-0000-000000000001", type = "User" }
+	role = "Admin"
+}
+
+resource "fabric_gateway_role_assignment" "second" {
+	gateway_id = fabric_gateway.example.id
+	principal = { id = "00000000-0000-0000-0000-000000000001", type = "User" }
+	role = "ConnectionCreator"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "duplicate - deployment pipeline role assignment",
+			content: `
+resource "fabric_deployment_pipeline_role_assignment" "first" {
+	deployment_pipeline_id = fabric_deployment_pipeline.example.id
+	principal = { id = "00000000-0000-0000-0000-000000000001", type = "User" }
+	role = "Admin"
+}
+
+resource "fabric_deployment_pipeline_role_assignment" "second" {
+	deployment_pipeline_id = fabric_deployment_pipeline.example.id
+	principal = { id = "00000000-0000-0000-0000-000000000001", type = "User" }
+	role = "Admin"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "conflict - principal in both domain admins and contributors",
+			content: `
+resource "fabric_domain_role_assignments" "admins" {
+	domain_id = fabric_domain.example.id
+	role = "Admins"
+	principals = [
+		{ id = "00000000-0000-0000-0000-000000000001", type = "User" },
+	]
+}
+
+resource "fabric_domain_role_assignments" "contributors" {
+	domain_id = fabric_domain.example.id
+	role = "Contributors"
+	principals = [
+		{ id = "00000000-0000-0000-0000-000000000002", type = "Group" },
+		{ id = "00000000-0000-0000-0000-000000000001", type = "User" },
+	]
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "duplicate - for_each instances with the same target and principal",
+			content: `
+resource "fabric_workspace_role_assignment" "team" {
+	for_each = {
+		admin  = "Admin"
+		viewer = "Viewer"
+	}
+	workspace_id = "11111111-1111-1111-1111-111111111111"
+	principal = { id = "00000000-0000-0000-0000-000000000001", type = "Group" }
+	role = each.value
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - for_each instances with different principals",
+			content: `
+resource "fabric_workspace_role_assignment" "team" {
+	for_each = {
+		"00000000-0000-0000-0000-000000000001" = "Admin"
+		"00000000-0000-0000-0000-000000000002" = "Viewer"
+	}
+	workspace_id = "11111111-1111-1111-1111-111111111111"
+	principal = { id = each.key, type = "Group" }
+	role = each.value
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "duplicate - count instances with the same target and principal",
+			content: `
+resource "fabric_gateway_role_assignment" "operators" {
+	count = 2
+	gateway_id = "11111111-1111-1111-1111-111111111111"
+	principal = { id = "00000000-0000-0000-0000-000000000001", type = "User" }
+	role = "Admin"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - count instances assigned to their own workspace instance",
+			content: `
+resource "fabric_workspace" "ws" {
+	count = 3
+	display_name = "Workspace ${count.index}"
+}
+
+resource "fabric_workspace_role_assignment" "ra" {
+	count = 3
+	workspace_id = fabric_workspace.ws[count.index].id
+	principal = { id = "00000000-0000-0000-0000-000000000001", type = "User" }
+	role = "Admin"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - for_each instances assigned to their own workspace instance",
+			content: `
+resource "fabric_workspace" "ws" {
+	for_each = {
+		dev  = "Dev"
+		prod = "Prod"
+	}
+	display_name = each.value
+}
+
+resource "fabric_workspace_role_assignment" "ra" {
+	for_each = {
+		dev  = "Admin"
+		prod = "Viewer"
+	}
+	workspace_id = fabric_workspace.ws[each.key].id
+	principal = { id = "00000000-0000-0000-0000-000000000001", type = "User" }
+	role = each.value
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "duplicate - count instances assigned to the same workspace instance",
+			content: `
+resource "fabric_workspace_role_assignment" "ra" {
+	count = 2
+	workspace_id = fabric_workspace.ws[0].id
+	principal = { id = "00000000-0000-0000-0000-000000000001", type = "User" }
+	role = "Admin"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - unresolvable principal is skipped",
+			content: `
+resource "fabric_workspace_role_assignment" "first" {
+	workspace_id = fabric_workspace.example.id
+	principal = { id = azuread_group.example.object_id, type = "Group" }
+	role = "Admin"
+}
+
+resource "fabric_workspace_role_assignment" "second" {
+	workspace_id = fabric_workspace.example.id
+	principal = { id = azuread_group.example.object_id, type = "Group" }
+	role = "Admin"
+}`,
+			hasIssue: false,
+		},
+	}
+
+	rule := NewFabricRoleAssignmentDuplicate()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricRoleAssignmentRecommended tests role assignment recommendations
+func TestFabricRoleAssignmentRecommended(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		hasIssue bool
+		message  string
+	}{
+		{
+			name: "valid - workspace with role assignment",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Test"
+}
+
+resource "fabric_workspace_role_assignment" "example" {
+	workspace_id = fabric_workspace.example.id
+	principal_id = "test-id"
+	principal_type = "User"
+	role = "Admin"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "warning - workspace without role assignment",
+			content: `resource "fabric_workspace" "example" {
+				display_name = "Test"
+			}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - deployment pipeline with role assignment",
+			content: `
+resource "fabric_deployment_pipeline" "example" {
+	display_name = "Test"
+	stages {
+		display_name = "dev"
+		is_public = true
+	}
+	stages {
+		display_name = "prod"
+		is_public = true
+	}
+}
+
+resource "fabric_deployment_pipeline_role_assignment" "example" {
+	deployment_pipeline_id = fabric_deployment_pipeline.example.id
+	principal_id = "test-id"
+	principal_type = "User"
+	role = "Admin"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "warning - role assignment for another workspace",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Test"
+}
+
+resource "fabric_workspace" "other" {
+	display_name = "Other"
+}
+
+resource "fabric_workspace_role_assignment" "example" {
+	workspace_id = fabric_workspace.other.id
+	role = "Admin"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - role assignment with index expression",
+			content: `
+resource "fabric_workspace" "example" {
+	count        = 2
+	display_name = "Test"
+}
+
+resource "fabric_workspace_role_assignment" "first" {
+	workspace_id = fabric_workspace.example[0].id
+	role = "Admin"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - role assignment with count.index",
+			content: `
+resource "fabric_workspace" "example" {
+	count        = 2
+	display_name = "Test"
+}
+
+resource "fabric_workspace_role_assignment" "example" {
+	count        = 2
+	workspace_id = fabric_workspace.example[count.index].id
+	role = "Admin"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - role assignment through for_each",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Test"
+}
+
+resource "fabric_workspace_role_assignment" "example" {
+	for_each = {
+		admin = fabric_workspace.example.id
+	}
+	workspace_id = each.value
+	role = "Admin"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - role assignment through for_each over locals",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Test"
+}
+
+locals {
+	workspaces = {
+		example = fabric_workspace.example
+	}
+	assignments = {
+		for key, workspace in local.workspaces : key => workspace.id
+	}
+}
+
+resource "fabric_workspace_role_assignment" "example" {
+	for_each = local.assignments
+	workspace_id = each.value
+	role = "Admin"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - role assignment through local",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Test"
+}
+
+locals {
+	workspace_id = fabric_workspace.example.id
+}
+
+resource "fabric_workspace_role_assignment" "example" {
+	workspace_id = local.workspace_id
+	role = "Admin"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - role assignment through data source",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Test"
+}
+
+data "fabric_workspace" "example" {
+	display_name = fabric_workspace.example.display_name
+}
+
+resource "fabric_workspace_role_assignment" "example" {
+	workspace_id = data.fabric_workspace.example.id
+	role = "Admin"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - role assignment through module output",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Test"
+}
+
+module "lookup" {
+	source       = "./lookup"
+	workspace_id = fabric_workspace.example.id
+}
+
+resource "fabric_workspace_role_assignment" "example" {
+	workspace_id = module.lookup.workspace_id
+	role = "Admin"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - workspace passed to role assignment module",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Test"
+}
+
+module "access" {
+	source       = "./access"
+	workspace_id = fabric_workspace.example.id
+}`,
+			config: `
+rule "fabric_role_assignment_recommended" {
+	enabled = true
+	role_assignment_modules = ["access"]
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "warning - workspace passed to module not configured for role assignments",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Test"
+}
+
+module "storage" {
+	source       = "./storage"
+	workspace_id = fabric_workspace.example.id
+}`,
+			config: `
+rule "fabric_role_assignment_recommended" {
+	enabled = true
+	role_assignment_modules = ["access"]
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "warning - workspace passed to module without configuration",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Test"
+}
+
+module "access" {
+	source       = "./access"
+	workspace_id = fabric_workspace.example.id
+}`,
+			hasIssue: true,
+			message:  "Workspace 'Test' (fabric_workspace.example) does not have any role assignments. This resource may not be accessible to users.",
+		},
+		{
+			name: "valid - domain with domain role assignments",
+			content: `
+resource "fabric_domain" "example" {
+	display_name = "Test"
+}
+
+resource "fabric_domain_role_assignments" "example" {
+	domain_id = fabric_domain.example.id
+	role = "Admins"
+	principals = [
+		{ id = "00000000-0000-0000-0000-000000000001", type = "User" },
+	]
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "warning - domain without role assignments",
+			content: `
+resource "fabric_domain" "example" {
+	display_name = "Test"
+}`,
+			hasIssue: true,
+		},
+	}
+
+	rule := NewFabricRoleAssignmentRecommended()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := map[string]string{"main.tf": tt.content}
+			if tt.config != "" {
+				files[".tflint.hcl"] = tt.config
+			}
+			runner := helper.TestRunner(t, files)
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+				if tt.message != "" && runner.Issues[0].Message != tt.message {
+					t.Fatalf("Expected message %q, but got %q", tt.message, runner.Issues[0].Message)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricWarehouseCollationType tests warehouse collation validation
+func TestFabricWarehouseCollationType(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hasIssue bool
+	}{
+		{
+			name: "valid - case-insensitive UTF-8 collation",
+			content: `
+resource "fabric_warehouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales"
+	configuration = {
+		collation_type = "Latin1_General_100_CI_AS_KS_WS_SC_UTF8"
+	}
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - binary collation as a block",
+			content: `
+resource "fabric_warehouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales"
+
+	configuration {
+		collation_type = "Latin1_General_100_BIN2_UTF8"
+	}
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - SQL Server collation",
+			content: `
+resource "fabric_warehouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales"
+	configuration = {
+		collation_type = "SQL_Latin1_General_CP1_CI_AS"
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - wrong case",
+			content: `
+resource "fabric_warehouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales"
+	configuration = {
+		collation_type = "latin1_general_100_bin2_utf8"
+	}
+}`,
+			hasIssue: true,
+		},
+	}
+
+	rule := NewFabricWarehouseCollationType()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricWarehouseSnapshotConfiguration tests warehouse snapshot parent and time validation
+func TestFabricWarehouseSnapshotConfiguration(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hasIssue bool
+	}{
+		{
+			name: "valid - parent warehouse in the same workspace",
+			content: `
+resource "fabric_warehouse" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales"
+}
+
+resource "fabric_warehouse_snapshot" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales-snapshot"
+	configuration = {
+		parent_warehouse_id = fabric_warehouse.example.id
+		snapshot_date_time  = "2025-01-31T18:00:00Z"
+	}
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - parent warehouse in another workspace",
+			content: `
+resource "fabric_warehouse" "example" {
+	workspace_id = fabric_workspace.dev.id
+	display_name = "sales"
+}
+
+resource "fabric_warehouse_snapshot" "example" {
+	workspace_id = fabric_workspace.prod.id
+	display_name = "sales-snapshot"
+
+	configuration {
+		parent_warehouse_id = fabric_warehouse.example.id
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - parent is a lakehouse",
+			content: `
+resource "fabric_warehouse_snapshot" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales-snapshot"
+	configuration = {
+		parent_warehouse_id = fabric_lakehouse.example.id
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - parent is another snapshot",
+			content: `
+resource "fabric_warehouse_snapshot" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales-snapshot"
+	configuration = {
+		parent_warehouse_id = fabric_warehouse_snapshot.other.id
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - snapshot time with an offset",
+			content: `
+resource "fabric_warehouse_snapshot" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales-snapshot"
+	configuration = {
+		parent_warehouse_id = fabric_warehouse.example.id
+		snapshot_date_time  = "2025-01-31T18:00:00+01:00"
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - snapshot date without a time",
+			content: `
+resource "fabric_warehouse_snapshot" "example" {
+	workspace_id = fabric_workspace.example.id
+	display_name = "sales-snapshot"
+	configuration = {
+		parent_warehouse_id = fabric_warehouse.example.id
+		snapshot_date_time  = "2025-01-31"
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - parent from a data source",
+			content: `
+data "fabric_warehouse" "example" {
+	workspace_id = fabric_workspace.dev.id
+	display_name = "sales"
+}
+
+resource "fabric_warehouse_snapshot" "example" {
+	workspace_id = fabric_workspace.prod.id
+	display_name = "sales-snapshot"
+	configuration = {
+		parent_warehouse_id = data.fabric_warehouse.example.id
+	}
+}`,
+			hasIssue: false,
+		},
+	}
+
+	rule := NewFabricWarehouseSnapshotConfiguration()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricWorkspaceCapacity tests capacity requirement
+func TestFabricWorkspaceCapacity(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hasIssue bool
+	}{
+		{
+			name: "valid - with capacity",
+			content: `resource "fabric_workspace" "example" {
+				display_name = "Test"
+				capacity_id = "test-capacity-id"
+			}`,
+			hasIssue: false,
+		},
+		{
+			name: "error - without capacity",
+			content: `resource "fabric_workspace" "example" {
+				display_name = "Test"
+			}`,
+			hasIssue: true,
+		},
+	}
+
+	rule := NewFabricWorkspaceCapacity()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricWorkspaceGitAzdoAttributes tests Azure DevOps git attributes
+func TestFabricWorkspaceGitAzdoAttributes(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hasIssue bool
+	}{
+		{
+			name: "valid - Azure DevOps with all required attributes",
+			content: `resource "fabric_workspace_git" "example" {
+				workspace_id = "test"
+				initialization_strategy = "PreferWorkspace"
+				git_provider_details {
+					git_provider_type = "AzureDevOps"
+					organization_name = "myorg"
+					project_name = "myproject"
+					repository_name = "myrepo"
+					branch_name = "main"
+					directory_name = "/test"
+				}
+			}`,
+			hasIssue: false,
+		},
+		{
+			name: "error - Azure DevOps missing organization_name",
+			content: `resource "fabric_workspace_git" "example" {
+				workspace_id = "test"
+				initialization_strategy = "PreferWorkspace"
+				git_provider_details {
+					git_provider_type = "AzureDevOps"
+					project_name = "myproject"
+					repository_name = "myrepo"
+					branch_name = "main"
+					directory_name = "/test"
+				}
+			}`,
+			hasIssue: true,
+		},
+		{
+			name: "error - Azure DevOps missing project_name",
+			content: `resource "fabric_workspace_git" "example" {
+				workspace_id = "test"
+				initialization_strategy = "PreferWorkspace"
+				git_provider_details {
+					git_provider_type = "AzureDevOps"
+					organization_name = "myorg"
+					repository_name = "myrepo"
+					branch_name = "main"
+					directory_name = "/test"
+				}
+			}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - GitHub (should not check Azure DevOps attributes)",
+			content: `resource "fabric_workspace_git" "example" {
+				workspace_id = "test"
+				initialization_strategy = "PreferWorkspace"
+				git_provider_details {
+					git_provider_type = "GitHub"
+					repository_name = "myrepo"
+					branch_name = "main"
+					directory_name = "/test"
+				}
+			}`,
+			hasIssue: false,
+		},
+	}
+
+	rule := NewFabricWorkspaceGitAzureDevOpsAttributes()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricWorkspaceGitCredentialsSource tests git credentials source validation
+func TestFabricWorkspaceGitCredentialsSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hasIssue bool
+	}{
+		{
+			name: "valid - GitHub with ConfiguredConnection",
+			content: `resource "fabric_workspace_git" "example" {
+				workspace_id = "test"
+				initialization_strategy = "PreferWorkspace"
+				git_credentials {
+					source = "ConfiguredConnection"
+				}
+				git_provider_details {
+					git_provider_type = "GitHub"
+					repository_name = "myrepo"
+					branch_name = "main"
+					directory_name = "/test"
+				}
+			}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - AzureDevOps with Automatic",
+			content: `resource "fabric_workspace_git" "example" {
+				workspace_id = "test"
+				initialization_strategy = "PreferWorkspace"
+				git_credentials {
+					source = "Automatic"
+				}
+				git_provider_details {
+					git_provider_type = "AzureDevOps"
+					organization_name = "myorg"
+					project_name = "myproject"
+					repository_name = "myrepo"
+					branch_name = "main"
+					directory_name = "/test"
+				}
+			}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - AzureDevOps with ConfiguredConnection",
+			content: `resource "fabric_workspace_git" "example" {
+				workspace_id = "test"
+				initialization_strategy = "PreferWorkspace"
+				git_credentials {
+					source = "ConfiguredConnection"
+				}
+				git_provider_details {
+					git_provider_type = "AzureDevOps"
+					organization_name = "myorg"
+					project_name = "myproject"
+					repository_name = "myrepo"
+					branch_name = "main"
+					directory_name = "/test"
+				}
+			}`,
+			hasIssue: false,
+		},
+		{
+			name: "error - invalid credentials source",
+			content: `resource "fabric_workspace_git" "example" {
+				workspace_id = "test"
+				initialization_strategy = "PreferWorkspace"
+				git_credentials {
+					source = "InvalidSource" 
+				}
+				git_provider_details {
+					git_provider_type = "GitHub"
+					repository_name = "myrepo"
+					branch_name = "main"
+					directory_name = "/test"
+				}
+			}`,
+			hasIssue: true,
+		},
+		{
+			name: "error - GitHub cannot use Automatic",
+			content: `resource "fabric_workspace_git" "example" {
+				workspace_id = "test"
+				initialization_strategy = "PreferWorkspace"
+				git_credentials {
+					source = "Automatic" 
+				}
+				git_provider_details {
+					git_provider_type = "GitHub"
+					repository_name = "myrepo"
+					branch_name = "main"
+					directory_name = "/test"
+				}
+			}`,
+			hasIssue: true,
+		},
+	}
+
+	rule := NewFabricWorkspaceGitCredentialsSource()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricWorkspaceGitDirectoryName tests directory name validation
+func TestFabricWorkspaceGitDirectoryName(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hasIssue bool
+	}{
+		{
+			name: "valid - starts with /",
+			content: `resource "fabric_workspace_git" "example" {
+				workspace_id = "test"
+				initialization_strategy = "PreferWorkspace"
+				git_provider_details {
+					git_provider_type = "GitHub"
+					repository_name = "myrepo"
+					branch_name = "main"
+					directory_name = "/test"
+				}
+			}`,
+			hasIssue: false,
+		},
+		{
+			name: "error - doesn't start with /",
+			content: `resource "fabric_workspace_git" "example" {
+				workspace_id = "test"
+				initialization_strategy = "PreferWorkspace"
+				git_provider_details {
+					git_provider_type = "GitHub"
+					repository_name = "myrepo"
+					branch_name = "main"
+					directory_name = "test"
+				}
+			}`,
+			hasIssue: true,
+		},
+		{
+			name: "error - exceeds max length",
+			content: `resource "fabric_workspace_git" "example" {
+				workspace_id = "test"
+				initialization_strategy = "PreferWorkspace"
+				git_provider_details {
+					git_provider_type = "GitHub"
+					repository_name = "myrepo"
+					branch_name = "main"
+					directory_name = "/` + string(make([]byte, 256)) + `"
+				}
+			}`,
+			hasIssue: true,
+		},
+	}
+
+	rule := NewFabricWorkspaceGitDirectoryName()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricWorkspaceGitGithubAttributes tests GitHub git attributes
+func TestFabricWorkspaceGitGithubAttributes(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hasIssue bool
+	}{
+		{
+			name: "valid - GitHub with all required attributes",
+			content: `resource "fabric_workspace_git" "example" {
+				workspace_id = "test"
+				initialization_strategy = "PreferWorkspace"
+				git_provider_details {
+					git_provider_type = "GitHub"
+					owner_name = "myowner"
+					repository_name = "myrepo"
+					branch_name = "main"
+					directory_name = "/test"
+				}
+			}`,
+			hasIssue: false,
+		},
+		{
+			name: "error - GitHub missing owner_name",
+			content: `resource "fabric_workspace_git" "example" {
+				workspace_id = "test"
+				initialization_strategy = "PreferWorkspace"
+				git_provider_details {
+					git_provider_type = "GitHub"
+					repository_name = "myrepo"
+					branch_name = "main"
+					directory_name = "/test"
+				}
+			}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - Azure DevOps (should not check GitHub attributes)",
+			content: `resource "fabric_workspace_git" "example" {
+				workspace_id = "test"
+				initialization_strategy = "PreferWorkspace"
+				git_provider_details {
+					git_provider_type = "AzureDevOps"
+					organization_name = "myorg"
+					project_name = "myproject"
+					repository_name = "myrepo"
+					branch_name = "main"
+					directory_name = "/test"
+				}
+			}`,
+			hasIssue: false,
+		},
+	}
+
+	rule := NewFabricWorkspaceGitGitHubAttributes()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricWorkspaceGitInitializationStrategy tests initialization strategy validation
+func TestFabricWorkspaceGitInitializationStrategy(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hasIssue bool
+	}{
+		{
+			name: "valid - PreferWorkspace",
+			content: `resource "fabric_workspace_git" "example" {
 				workspace_id = "test"
 				initialization_strategy = "PreferWorkspace"
-				git_credentials {
-					source = "ConfiguredConnection"
-				}
 				git_provider_details {
 					git_provider_type = "GitHub"
 					repository_name = "myrepo"
@@ -720,17 +3788,12 @@ func TestFabricWorkspaceGitCredentialsSource(t *testing.T) {
 			hasIssue: false,
 		},
 		{
-			name: "valid - AzureDevOps with Automatic",
+			name: "valid - PreferRemote",
 			content: `resource "fabric_workspace_git" "example" {
 				workspace_id = "test"
-				initialization_strategy = "PreferWorkspace"
-				git_credentials {
-					source = "Automatic"
-				}
+				initialization_strategy = "PreferRemote"
 				git_provider_details {
-					git_provider_type = "AzureDevOps"
-					organization_name = "myorg"
-					project_name = "myproject"
+					git_provider_type = "GitHub"
 					repository_name = "myrepo"
 					branch_name = "main"
 					directory_name = "/test"
@@ -739,32 +3802,53 @@ func TestFabricWorkspaceGitCredentialsSource(t *testing.T) {
 			hasIssue: false,
 		},
 		{
-			name: "valid - AzureDevOps with ConfiguredConnection",
+			name: "error - invalid strategy",
 			content: `resource "fabric_workspace_git" "example" {
 				workspace_id = "test"
-				initialization_strategy = "PreferWorkspace"
-				git_credentials {
-					source = "ConfiguredConnection"
-				}
+				initialization_strategy = "InvalidStrategy"
 				git_provider_details {
-					git_provider_type = "AzureDevOps"
-					organization_name = "myorg"
-					project_name = "myproject"
+					git_provider_type = "GitHub"
 					repository_name = "myrepo"
 					branch_name = "main"
 					directory_name = "/test"
 				}
 			}`,
-			hasIssue: false,
+			hasIssue: true,
 		},
+	}
+
+	rule := NewFabricWorkspaceGitInitializationStrategy()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
+				}
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricWorkspaceGitProviderType tests git provider type validation
+func TestFabricWorkspaceGitProviderType(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		hasIssue bool
+	}{
 		{
-			name: "error - invalid credentials source",
+			name: "valid - GitHub",
 			content: `resource "fabric_workspace_git" "example" {
 				workspace_id = "test"
 				initialization_strategy = "PreferWorkspace"
-				git_credentials {
-					source = "InvalidSource" 
-				}
 				git_provider_details {
 					git_provider_type = "GitHub"
 					repository_name = "myrepo"
@@ -772,18 +3856,31 @@ func TestFabricWorkspaceGitCredentialsSource(t *testing.T) {
 					directory_name = "/test"
 				}
 			}`,
-			hasIssue: true,
+			hasIssue: false,
 		},
 		{
-			name: "error - GitHub cannot use Automatic",
+			name: "valid - AzureDevOps",
 			content: `resource "fabric_workspace_git" "example" {
 				workspace_id = "test"
 				initialization_strategy = "PreferWorkspace"
-				git_credentials {
-					source = "Automatic" 
+				git_provider_details {
+					git_provider_type = "AzureDevOps"
+					organization_name = "myorg"
+					project_name = "myproject"
+					repository_name = "myrepo"
+					branch_name = "main"
+					directory_name = "/test"
 				}
+			}`,
+			hasIssue: false,
+		},
+		{
+			name: "error - invalid provider type",
+			content: `resource "fabric_workspace_git" "example" {
+				workspace_id = "test"
+				initialization_strategy = "PreferWorkspace"
 				git_provider_details {
-					git_provider_type = "GitHub"
+					git_provider_type = "GitLab"
 					repository_name = "myrepo"
 					branch_name = "main"
 					directory_name = "/test"
@@ -793,7 +3890,7 @@ func TestFabricWorkspaceGitCredentialsSource(t *testing.T) {
 		},
 	}
 
-	rule := NewFabricWorkspaceGitCredentialsSource()
+	rule := NewFabricWorkspaceGitProviderType()
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
@@ -813,21 +3910,22 @@ func TestFabricWorkspaceGitCredentialsSource(t *testing.T) {
 	}
 }
 
-// TestFabricWorkspaceGitDirectoryName tests directory name validation
-func TestFabricWorkspaceGitDirectoryName(t *testing.T) {
+// TestFabricWorkspaceGitStringLengths tests git string length validations
+func TestFabricWorkspaceGitStringLengths(t *testing.T) {
 	tests := []struct {
 		name     string
 		content  string
 		hasIssue bool
 	}{
 		{
-			name: "valid - starts with /",
+			name: "valid - all within limits",
 			content: `resource "fabric_workspace_git" "example" {
 				workspace_id = "test"
 				initialization_strategy = "PreferWorkspace"
 				git_provider_details {
 					git_provider_type = "GitHub"
-					repository_name = "myrepo"
+					owner_name = "owner"
+					repository_name = "repo"
 					branch_name = "main"
 					directory_name = "/test"
 				}
@@ -835,39 +3933,297 @@ func TestFabricWorkspaceGitDirectoryName(t *testing.T) {
 			hasIssue: false,
 		},
 		{
-			name: "error - doesn't start with /",
+			name: "error - repository_name exceeds limit",
 			content: `resource "fabric_workspace_git" "example" {
 				workspace_id = "test"
 				initialization_strategy = "PreferWorkspace"
 				git_provider_details {
 					git_provider_type = "GitHub"
-					repository_name = "myrepo"
+					repository_name = "` + string(make([]byte, 129)) + `"
 					branch_name = "main"
-					directory_name = "test"
+					directory_name = "/test"
 				}
 			}`,
 			hasIssue: true,
 		},
-		{
-			name: "error - exceeds max length",
-			content: `resource "fabric_workspace_git" "example" {
-				workspace_id = "test"
-				initialization_strategy = "PreferWorkspace"
-				git_provider_details {
-					git_provider_type = "GitHub"
-					repository_name = "myrepo"
-					branch_name = "main"
-					directory_name = "/` + string(make([]byte, 256)) + `"
+	}
+
+	rule := NewFabricWorkspaceGitStringLengths()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			if err := rule.Check(runner); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(runner.Issues) > 0 {
+				if !tt.hasIssue {
+					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
 				}
-			}`,
+			} else {
+				if tt.hasIssue {
+					t.Fatal("Expected issues, but got none")
+				}
+			}
+		})
+	}
+}
+
+// TestFabricWorkspaceIdentityRequired tests detection of identity dependents in workspaces without an identity
+func TestFabricWorkspaceIdentityRequired(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		config   string
+		hasIssue bool
+	}{
+		{
+			name: "valid - managed private endpoint in workspace with identity block",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+	identity {
+		type = "SystemAssigned"
+	}
+}
+
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id                    = fabric_workspace.example.id
+	name                            = "storage"
+	target_private_link_resource_id = "/subscriptions/0000/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/example"
+	target_subresource_type         = "blob"
+	request_message                 = "Fabric"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - managed private endpoint in workspace with identity attribute",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+	identity     = { type = "SystemAssigned" }
+}
+
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id = fabric_workspace.example.id
+	name         = "storage"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - managed private endpoint in workspace without identity",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+}
+
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id = fabric_workspace.example.id
+	name         = "storage"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - identity set to null",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+	identity     = null
+}
+
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id = fabric_workspace.example.id
+	name         = "storage"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - identity from a variable",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+	identity     = var.identity
+}
+
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id = fabric_workspace.example.id
+	name         = "storage"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - shortcut using a WorkspaceIdentity connection",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+}
+
+resource "fabric_connection" "storage" {
+	display_name      = "storage"
+	connectivity_type = "ShareableCloud"
+	credential_details = {
+		credential_type = "WorkspaceIdentity"
+	}
+}
+
+resource "fabric_shortcut" "raw" {
+	workspace_id = fabric_workspace.example.id
+	item_id      = fabric_lakehouse.example.id
+	name         = "raw"
+	path         = "Files"
+	target = {
+		adls_gen2 = {
+			connection_id = fabric_connection.storage.id
+			location      = "https://example.dfs.core.windows.net"
+			subpath       = "/raw"
+		}
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "invalid - shortcut blocks using a WorkspaceIdentity connection through a local",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+}
+
+resource "fabric_connection" "storage" {
+	display_name      = "storage"
+	connectivity_type = "ShareableCloud"
+	credential_details {
+		credential_type = "WorkspaceIdentity"
+	}
+}
+
+locals {
+	connection_id = fabric_connection.storage.id
+}
+
+resource "fabric_shortcut" "raw" {
+	workspace_id = fabric_workspace.example.id
+	item_id      = fabric_lakehouse.example.id
+	name         = "raw"
+	path         = "Files"
+	target {
+		azure_blob_storage {
+			connection_id = local.connection_id
+		}
+	}
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - shortcut using a service principal connection",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+}
+
+resource "fabric_connection" "storage" {
+	display_name      = "storage"
+	connectivity_type = "ShareableCloud"
+	credential_details = {
+		credential_type = "ServicePrincipal"
+	}
+}
+
+resource "fabric_shortcut" "raw" {
+	workspace_id = fabric_workspace.example.id
+	item_id      = fabric_lakehouse.example.id
+	name         = "raw"
+	path         = "Files"
+	target = {
+		adls_gen2 = {
+			connection_id = fabric_connection.storage.id
+		}
+	}
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - ADLS Gen2 shortcut with adls_gen2_shortcuts enabled",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+}
+
+resource "fabric_shortcut" "raw" {
+	workspace_id = fabric_workspace.example.id
+	item_id      = fabric_lakehouse.example.id
+	name         = "raw"
+	path         = "Files"
+	target = {
+		adls_gen2 = {
+			connection_id = "00000000-0000-0000-0000-000000000000"
+		}
+	}
+}`,
+			config: `
+rule "fabric_workspace_identity_required" {
+	enabled             = true
+	adls_gen2_shortcuts = true
+}`,
 			hasIssue: true,
 		},
+		{
+			name: "valid - OneLake shortcut with adls_gen2_shortcuts enabled",
+			content: `
+resource "fabric_workspace" "example" {
+	display_name = "Analytics"
+}
+
+resource "fabric_shortcut" "raw" {
+	workspace_id = fabric_workspace.example.id
+	item_id      = fabric_lakehouse.example.id
+	name         = "raw"
+	path         = "Tables"
+	target = {
+		onelake = {
+			workspace_id = fabric_workspace.example.id
+			item_id      = fabric_lakehouse.source.id
+			path         = "Tables/sales"
+		}
+	}
+}`,
+			config: `
+rule "fabric_workspace_identity_required" {
+	enabled             = true
+	adls_gen2_shortcuts = true
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - workspace from data source is unknown",
+			content: `
+data "fabric_workspace" "existing" {
+	display_name = "Analytics"
+}
+
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id = data.fabric_workspace.existing.id
+	name         = "storage"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "valid - workspace not declared in this module",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id = fabric_workspace.shared.id
+	name         = "storage"
+}`,
+			hasIssue: false,
+		},
 	}
 
-	rule := NewFabricWorkspaceGitDirectoryName()
+	rule := NewFabricWorkspaceIdentityRequired()
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
+			files := map[string]string{"main.tf": tt.content}
+			if tt.config != "" {
+				files[".tflint.hcl"] = tt.config
+			}
+			runner := helper.TestRunner(t, files)
 			if err := rule.Check(runner); err != nil {
 				t.Fatalf("Unexpected error: %s", err)
 			}
@@ -884,61 +4240,56 @@ func TestFabricWorkspaceGitDirectoryName(t *testing.T) {
 	}
 }
 
-// TestFabricWorkspaceGitGithubAttributes tests GitHub git attributes
-func TestFabricWorkspaceGitGithubAttributes(t *testing.T) {
+// TestFabricWorkspaceManagedPrivateEndpointRequestMessage tests request message detection
+func TestFabricWorkspaceManagedPrivateEndpointRequestMessage(t *testing.T) {
 	tests := []struct {
 		name     string
 		content  string
 		hasIssue bool
 	}{
 		{
-			name: "valid - GitHub with all required attributes",
-			content: `resource "fabric_workspace_git" "example" {
-				workspace_id = "test"
-				initialization_strategy = "PreferWorkspace"
-				git_provider_details {
-					git_provider_type = "GitHub"
-					owner_name = "myowner"
-					repository_name = "myrepo"
-					branch_name = "main"
-					directory_name = "/test"
-				}
-			}`,
+			name: "valid - request message set",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id    = fabric_workspace.example.id
+	name            = "storage"
+	request_message = "Fabric workspace Analytics needs access for the sales lakehouse"
+}`,
 			hasIssue: false,
 		},
 		{
-			name: "error - GitHub missing owner_name",
-			content: `resource "fabric_workspace_git" "example" {
-				workspace_id = "test"
-				initialization_strategy = "PreferWorkspace"
-				git_provider_details {
-					git_provider_type = "GitHub"
-					repository_name = "myrepo"
-					branch_name = "main"
-					directory_name = "/test"
-				}
-			}`,
+			name: "invalid - request message missing",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id = fabric_workspace.example.id
+	name         = "storage"
+}`,
 			hasIssue: true,
 		},
 		{
-			name: "valid - Azure DevOps (should not check GitHub attributes)",
-			content: `resource "fabric_workspace_git" "example" {
-				workspace_id = "test"
-				initialization_strategy = "PreferWorkspace"
-				git_provider_details {
-					git_provider_type = "AzureDevOps"
-					organization_name = "myorg"
-					project_name = "myproject"
-					repository_name = "myrepo"
-					branch_name = "main"
-					directory_name = "/test"
-				}
-			}`,
+			name: "invalid - request message blank",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id    = fabric_workspace.example.id
+	name            = "storage"
+	request_message = "  "
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - request message from a variable",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id    = fabric_workspace.example.id
+	name            = "storage"
+	request_message = var.request_message
+}`,
 			hasIssue: false,
 		},
 	}
 
-	rule := NewFabricWorkspaceGitGitHubAttributes()
+	rule := NewFabricWorkspaceManagedPrivateEndpointRequestMessage()
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
@@ -958,189 +4309,178 @@ func TestFabricWorkspaceGitGithubAttributes(t *testing.T) {
 	}
 }
 
-// TestFabricWorkspaceGitInitializationStrategy tests initialization strategy validation
-func TestFabricWorkspaceGitInitializationStrategy(t *testing.T) {
+// TestFabricWorkspaceManagedPrivateEndpointTarget tests managed private endpoint target validation
+func TestFabricWorkspaceManagedPrivateEndpointTarget(t *testing.T) {
 	tests := []struct {
 		name     string
 		content  string
 		hasIssue bool
 	}{
 		{
-			name: "valid - PreferWorkspace",
-			content: `resource "fabric_workspace_git" "example" {
-				workspace_id = "test"
-				initialization_strategy = "PreferWorkspace"
-				git_provider_details {
-					git_provider_type = "GitHub"
-					repository_name = "myrepo"
-					branch_name = "main"
-					directory_name = "/test"
-				}
-			}`,
+			name: "valid - storage account with dfs",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id                    = fabric_workspace.example.id
+	name                            = "storage"
+	target_private_link_resource_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-data/providers/Microsoft.Storage/storageAccounts/stdata"
+	target_subresource_type         = "dfs"
+}`,
 			hasIssue: false,
 		},
 		{
-			name: "valid - PreferRemote",
-			content: `resource "fabric_workspace_git" "example" {
-				workspace_id = "test"
-				initialization_strategy = "PreferRemote"
-				git_provider_details {
-					git_provider_type = "GitHub"
-					repository_name = "myrepo"
-					branch_name = "main"
-					directory_name = "/test"
-				}
-			}`,
-			hasIssue: false,
+			name: "invalid - malformed resource ID",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id                    = fabric_workspace.example.id
+	name                            = "storage"
+	target_private_link_resource_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-data/Microsoft.Storage/storageAccounts/stdata"
+	target_subresource_type         = "dfs"
+}`,
+			hasIssue: true,
 		},
 		{
-			name: "error - invalid strategy",
-			content: `resource "fabric_workspace_git" "example" {
-				workspace_id = "test"
-				initialization_strategy = "InvalidStrategy"
-				git_provider_details {
-					git_provider_type = "GitHub"
-					repository_name = "myrepo"
-					branch_name = "main"
-					directory_name = "/test"
-				}
-			}`,
+			name: "invalid - subscription is not a GUID",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id                    = fabric_workspace.example.id
+	name                            = "storage"
+	target_private_link_resource_id = "/subscriptions/production/resourceGroups/rg-data/providers/Microsoft.Storage/storageAccounts/stdata"
+	target_subresource_type         = "blob"
+}`,
 			hasIssue: true,
 		},
-	}
-
-	rule := NewFabricWorkspaceGitInitializationStrategy()
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
-			if err := rule.Check(runner); err != nil {
-				t.Fatalf("Unexpected error: %s", err)
-			}
-			if len(runner.Issues) > 0 {
-				if !tt.hasIssue {
-					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
-				}
-			} else {
-				if tt.hasIssue {
-					t.Fatal("Expected issues, but got none")
-				}
-			}
-		})
-	}
-}
-
-// TestFabricWorkspaceGitProviderType tests git provider type validation
-func TestFabricWorkspaceGitProviderType(t *testing.T) {
-	tests := []struct {
-		name     string
-		content  string
-		hasIssue bool
-	}{
 		{
-			name: "valid - GitHub",
-			content: `resource "fabric_workspace_git" "example" {
-				workspace_id = "test"
-				initialization_strategy = "PreferWorkspace"
-				git_provider_details {
-					git_provider_type = "GitHub"
-					repository_name = "myrepo"
-					branch_name = "main"
-					directory_name = "/test"
-				}
-			}`,
+			name: "invalid - vault sub-resource for a storage account",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id                    = fabric_workspace.example.id
+	name                            = "storage"
+	target_private_link_resource_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-data/providers/Microsoft.Storage/storageAccounts/stdata"
+	target_subresource_type         = "vault"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - SQL server with sqlServer",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id                    = fabric_workspace.example.id
+	name                            = "sql"
+	target_private_link_resource_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-data/providers/Microsoft.Sql/servers/sql-data"
+	target_subresource_type         = "sqlServer"
+}`,
 			hasIssue: false,
 		},
 		{
-			name: "valid - AzureDevOps",
-			content: `resource "fabric_workspace_git" "example" {
-				workspace_id = "test"
-				initialization_strategy = "PreferWorkspace"
-				git_provider_details {
-					git_provider_type = "AzureDevOps"
-					organization_name = "myorg"
-					project_name = "myproject"
-					repository_name = "myrepo"
-					branch_name = "main"
-					directory_name = "/test"
-				}
-			}`,
+			name: "invalid - blob sub-resource for a referenced key vault",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id                    = fabric_workspace.example.id
+	name                            = "vault"
+	target_private_link_resource_id = azurerm_key_vault.example.id
+	target_subresource_type         = "blob"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - unknown resource provider",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "example" {
+	workspace_id                    = fabric_workspace.example.id
+	name                            = "custom"
+	target_private_link_resource_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-data/providers/Contoso.Data/stores/example"
+	target_subresource_type         = "store"
+}`,
 			hasIssue: false,
 		},
 		{
-			name: "error - invalid provider type",
-			content: `resource "fabric_workspace_git" "example" {
-				workspace_id = "test"
-				initialization_strategy = "PreferWorkspace"
-				git_provider_details {
-					git_provider_type = "GitLab"
-					repository_name = "myrepo"
-					branch_name = "main"
-					directory_name = "/test"
-				}
-			}`,
+			name: "invalid - duplicate endpoint to the same target",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "a" {
+	workspace_id                    = fabric_workspace.example.id
+	name                            = "storage-a"
+	target_private_link_resource_id = azurerm_storage_account.example.id
+	target_subresource_type         = "dfs"
+}
+
+resource "fabric_workspace_managed_private_endpoint" "b" {
+	workspace_id                    = fabric_workspace.example.id
+	name                            = "storage-b"
+	target_private_link_resource_id = azurerm_storage_account.example.id
+	target_subresource_type         = "dfs"
+}`,
 			hasIssue: true,
 		},
+		{
+			name: "valid - for_each endpoints to their own storage account and workspace instances",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "storage" {
+	for_each = {
+		dev  = "storage-dev"
+		prod = "storage-prod"
 	}
-
-	rule := NewFabricWorkspaceGitProviderType()
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
-			if err := rule.Check(runner); err != nil {
-				t.Fatalf("Unexpected error: %s", err)
-			}
-			if len(runner.Issues) > 0 {
-				if !tt.hasIssue {
-					t.Fatalf("Expected no issues, but got: %v", runner.Issues)
-				}
-			} else {
-				if tt.hasIssue {
-					t.Fatal("Expected issues, but got none")
-				}
-			}
-		})
+	workspace_id                    = fabric_workspace.ws[each.key].id
+	name                            = each.value
+	target_private_link_resource_id = azurerm_storage_account.sa[each.key].id
+	target_subresource_type         = "dfs"
+}`,
+			hasIssue: false,
+		},
+		{
+			name: "invalid - for_each endpoints to the same storage account instance",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "storage" {
+	for_each = {
+		a = "storage-a"
+		b = "storage-b"
 	}
+	workspace_id                    = fabric_workspace.example.id
+	name                            = each.value
+	target_private_link_resource_id = azurerm_storage_account.sa["shared"].id
+	target_subresource_type         = "dfs"
+}`,
+			hasIssue: true,
+		},
+		{
+			name: "valid - same target with different sub-resources",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "blob" {
+	workspace_id                    = fabric_workspace.example.id
+	name                            = "storage-blob"
+	target_private_link_resource_id = azurerm_storage_account.example.id
+	target_subresource_type         = "blob"
 }
 
-// TestFabricWorkspaceGitStringLengths tests git string length validations
-func TestFabricWorkspaceGitStringLengths(t *testing.T) {
-	tests := []struct {
-		name     string
-		content  string
-		hasIssue bool
-	}{
-		{
-			name: "valid - all within limits",
-			content: `resource "fabric_workspace_git" "example" {
-				workspace_id = "test"
-				initialization_strategy = "PreferWorkspace"
-				git_provider_details {
-					git_provider_type = "GitHub"
-					owner_name = "owner"
-					repository_name = "repo"
-					branch_name = "main"
-					directory_name = "/test"
-				}
-			}`,
+resource "fabric_workspace_managed_private_endpoint" "dfs" {
+	workspace_id                    = fabric_workspace.example.id
+	name                            = "storage-dfs"
+	target_private_link_resource_id = azurerm_storage_account.example.id
+	target_subresource_type         = "dfs"
+}`,
 			hasIssue: false,
 		},
 		{
-			name: "error - repository_name exceeds limit",
-			content: `resource "fabric_workspace_git" "example" {
-				workspace_id = "test"
-				initialization_strategy = "PreferWorkspace"
-				git_provider_details {
-					git_provider_type = "GitHub"
-					repository_name = "` + string(make([]byte, 129)) + `"
-					branch_name = "main"
-					directory_name = "/test"
-				}
-			}`,
-			hasIssue: true,
+			name: "valid - same target in different workspaces",
+			content: `
+resource "fabric_workspace_managed_private_endpoint" "dev" {
+	workspace_id                    = fabric_workspace.dev.id
+	name                            = "storage"
+	target_private_link_resource_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-data/providers/Microsoft.Storage/storageAccounts/stdata"
+	target_subresource_type         = "dfs"
+}
+
+resource "fabric_workspace_managed_private_endpoint" "prod" {
+	workspace_id                    = fabric_workspace.prod.id
+	name                            = "storage"
+	target_private_link_resource_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-data/providers/Microsoft.Storage/storageAccounts/stdata"
+	target_subresource_type         = "dfs"
+}`,
+			hasIssue: false,
 		},
 	}
 
-	rule := NewFabricWorkspaceGitStringLengths()
+	rule := NewFabricWorkspaceManagedPrivateEndpointTarget()
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			runner := helper.TestRunner(t, map[string]string{"main.tf": tt.content})
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// The catalog (rules/apispec/catalog.json) lists every generated rule with its constraints and where each constraint
+// came from, so releases can be audited and diffed without reading the generated code.
+
+type catalogEntry struct {
+	Name      string `json:"name"`
+	BlockType string `json:"block_type"`
+	Resource  string `json:"resource"`
+	// Attribute is the dotted attribute path, empty for cross-attribute constraint rules
+	Attribute   string              `json:"attribute,omitempty"`
+	Severity    string              `json:"severity"`
+	Constraints []catalogConstraint `json:"constraints"`
+	Provenance  catalogProvenance   `json:"provenance"`
+}
+
+type catalogConstraint struct {
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+	// Source is "mapping", "schema" or "spec", the source that won the merge
+	Source string `json:"source"`
+}
+
+type catalogProvenance struct {
+	MappingFile string `json:"mapping_file"`
+	// SpecFile is relative to the fabric-rest-api-specs repository
+	SpecFile   string `json:"spec_file,omitempty"`
+	SchemaHash string `json:"schema_hash,omitempty"`
+	// Manual are the "// MANUAL:" comments written above the blocks the rule was generated from
+	Manual []string `json:"manual,omitempty"`
+}
+
+var catalogEntries []catalogEntry
+
+// specLocation is a JSON pointer into an API spec file, the file relative to -specs-path
+type specLocation struct {
+	file    string
+	pointer string
+}
+
+// follow returns the location a $ref of node points to, or loc when node has no $ref
+func (loc specLocation) follow(node map[string]interface{}) specLocation {
+	ref, ok := node["$ref"].(string)
+	if !ok || ref == "" {
+		return loc
+	}
+	file, fragment, _ := strings.Cut(ref, "#")
+	if file != "" {
+		loc.file = path.Clean(path.Join(path.Dir(loc.file), file))
+	}
+	loc.pointer = fragment
+	return loc
+}
+
+// property returns the location of property name of the schema at loc
+func (loc specLocation) property(name string) specLocation {
+	loc.pointer += "/properties/" + jsonPointerEscape(name)
+	return loc
+}
+
+var fileHashes = map[string]string{}
+
+// fileHash returns the sha256 of a file as "sha256:<hex>", or "" when the file cannot be read
+func fileHash(fileName string) string {
+	if hash, ok := fileHashes[fileName]; ok {
+		return hash
+	}
+	hash := ""
+	if content, err := os.ReadFile(fileName); err == nil {
+		sum := sha256.Sum256(content)
+		hash = "sha256:" + hex.EncodeToString(sum[:])
+	}
+	fileHashes[fileName] = hash
+	return hash
+}
+
+// manualComments returns the "// MANUAL:" comments written directly above each block of a mapping file, keyed by
+// mapping resource and then block path, e.g. "attribute.display_name" or "data_source.fabric_lakehouse.constraint.lookup"
+// Comments are not part of the decoded HCL body, so they are read from the raw file at each block's line.
+func manualComments(f *hcl.File) map[string]map[string][]string {
+	comments := make(map[string]map[string][]string)
+	body, ok := f.Body.(*hclsyntax.Body)
+	if !ok {
+		return comments
+	}
+	lines := strings.Split(string(f.Bytes), "\n")
+
+	var walk func(blocks hclsyntax.Blocks, prefix string, found map[string][]string)
+	walk = func(blocks hclsyntax.Blocks, prefix string, found map[string][]string) {
+		for _, block := range blocks {
+			if len(block.Labels) == 0 {
+				continue
+			}
+			key := prefix + block.Type + "." + block.Labels[0]
+			var manual []string
+			for i := block.TypeRange.Start.Line - 2; i >= 0; i-- {
+				line := strings.TrimSpace(lines[i])
+				if !strings.HasPrefix(line, "//") {
+					break
+				}
+				// Auto-generated comments between MANUAL comments and the block are skipped
+				if text, ok := strings.CutPrefix(line, "// MANUAL:"); ok {
+					manual = append([]string{strings.TrimSpace(text)}, manual...)
+				}
+			}
+			if len(manual) > 0 {
+				found[key] = manual
+			}
+			walk(block.Body.Blocks, key+".", found)
+		}
+	}
+
+	for _, block := range body.Blocks {
+		if block.Type != "mapping" || len(block.Labels) == 0 {
+			continue
+		}
+		found := make(map[string][]string)
+		walk(block.Body.Blocks, "", found)
+		comments[block.Labels[0]] = found
+	}
+	return comments
+}
+
+// catalogAttributeRule records the constraints of a generated attribute rule and the source each value won from
+func catalogAttributeRule(m mapping, ref attributeRef, meta *ruleMeta, definition map[string]interface{}, manual *manualConstraint, loc specLocation) {
+	if manual == nil {
+		manual = &manualConstraint{}
+	}
+	constraints := []catalogConstraint{}
+	add := func(kind string, value any, source string) {
+		constraints = append(constraints, catalogConstraint{Type: kind, Value: value, Source: source})
+	}
+
+	if meta.SetMaxLength {
+		source := "spec"
+		if manual.MaxLength != nil {
+			source = "mapping"
+		} else if _, ok := schemaConstraints[m.Resource][ref.path()]; ok {
+			source = "schema"
+		}
+		add("max_length", meta.MaxLength, source)
+	}
+	if meta.SetMinLength {
+		source := "spec"
+		if manual.MinLength != nil {
+			source = "mapping"
+		}
+		add("min_length", meta.MinLength, source)
+	}
+	if len(meta.Enum) > 0 {
+		source := "spec"
+		if len(manual.ValidValues) > 0 && contains(manual.ValidValues, meta.Enum[0]) {
+			source = "mapping"
+		}
+		add("enum", meta.Enum, source)
+	}
+	if meta.Pattern != "" {
+		source := "mapping"
+		if manual.Pattern == nil && fetchString(definition, "pattern") != "" {
+			source = "spec"
+		}
+		add("pattern", meta.Pattern, source)
+	}
+	if len(meta.ReservedWords) > 0 {
+		add("reserved_words", meta.ReservedWords, "mapping")
+	}
+
+	keys := []string{"attribute." + ref.path()}
+	if ref.dataSource {
+		keys = append(keys, "data_source."+ref.resource)
+	}
+	provenance := m.provenance(keys...)
+	provenance.SpecFile = loc.file
+
+	catalogEntries = append(catalogEntries, catalogEntry{
+		Name:        meta.RuleName,
+		BlockType:   meta.BlockKind,
+		Resource:    meta.ResourceType,
+		Attribute:   meta.AttributePath,
+		Severity:    strings.ToLower(meta.Severity),
+		Constraints: constraints,
+		Provenance:  provenance,
+	})
+}
+
+// catalogConstraintRule records a generated cross-attribute constraint rule, from the mapping or, for variants, the spec
+func catalogConstraintRule(m mapping, c constraint, meta *constraintMeta) {
+	value := map[string]any{"paths": meta.Paths}
+	if meta.Attribute != "" {
+		value["attribute"] = meta.Attribute
+	}
+	if meta.WhenAttribute != "" {
+		value["when_attribute"] = meta.WhenAttribute
+		value["when_equals"] = meta.WhenEquals
+	}
+	if meta.Message != "" {
+		value["message"] = meta.Message
+	}
+
+	keys := []string{"constraint." + c.Name}
+	if meta.BlockKind == "data" {
+		keys = []string{"data_source." + meta.ResourceType, "data_source." + meta.ResourceType + ".constraint." + c.Name}
+	}
+	if c.block != "" {
+		keys = []string{c.block}
+	}
+
+	source, provenance := "mapping", m.provenance(keys...)
+	if c.source != nil {
+		source = "spec"
+		provenance.SpecFile = c.source.file
+	}
+
+	catalogEntries = append(catalogEntries, catalogEntry{
+		Name:        meta.RuleName,
+		BlockType:   meta.BlockKind,
+		Resource:    meta.ResourceType,
+		Severity:    "error",
+		Constraints: []catalogConstraint{{Type: meta.Kind, Value: value, Source: source}},
+		Provenance:  provenance,
+	})
+}
+
+// provenance returns the mapping file, schema.json hash and "// MANUAL:" comments of the given blocks of m
+func (m mapping) provenance(keys ...string) catalogProvenance {
+	provenance := catalogProvenance{
+		MappingFile: m.file,
+		SchemaHash:  fileHash(getFullPath("schema/schema.json")),
+	}
+	for _, key := range keys {
+		provenance.Manual = append(provenance.Manual, m.manualComments[key]...)
+	}
+	return provenance
+}
+
+// writeCatalog writes catalog.json, sorted by rule name, and the Go file embedding it
+func writeCatalog() {
+	sort.Slice(catalogEntries, func(i, j int) bool { return catalogEntries[i].Name < catalogEntries[j].Name })
+	content, err := json.MarshalIndent(struct {
+		Rules []catalogEntry `json:"rules"`
+	}{catalogEntries}, "", "  ")
+	if err != nil {
+		panic(fmt.Sprintf("Failed to write catalog: %v", err))
+	}
+
+	writeFile(fmt.Sprintf("%s/apispec/catalog.json", RulesPath), append(content, '\n'))
+	generateFile(fmt.Sprintf("%s/apispec/catalog.go", RulesPath), getFullPath("catalog.go.tmpl"), nil)
+}
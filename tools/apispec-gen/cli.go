@@ -0,0 +1,225 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclparse"
+)
+
+// apispec-gen is run as "apispec-gen <command> [flags]". Paths come from the flags, then from the config file, then
+// from the defaults below, so every command runs the same way from the repository root.
+
+// configFileName is looked up in the working directory and its parents when -config is not given
+const configFileName = "apispec-gen.hcl"
+
+// config is the config file, its relative paths resolved against the directory of the file
+type config struct {
+	SpecsPath string `hcl:"specs_path,optional"`
+	BasePath  string `hcl:"base_path,optional"`
+	RulesPath string `hcl:"rules_path,optional"`
+	DocsPath  string `hcl:"docs_path,optional"`
+}
+
+type command struct {
+	description string
+	// outputs are the kinds of generated files the command writes, see outputKind
+	outputs []string
+	run     func(skipExisting bool) bool
+}
+
+var commands = map[string]command{
+	"mappings": {
+		description: "Generate mapping files from the API specs",
+		run: func(skipExisting bool) bool {
+			generateMappings(skipExisting)
+			return false
+		},
+	},
+	"rules": {
+		description: "Generate rules, their registry and shared helpers",
+		outputs:     []string{"rules"},
+		run:         runGenerateRules,
+	},
+	"tests": {
+		description: "Generate a _test.go for every rule",
+		outputs:     []string{"tests"},
+		run:         runGenerateRules,
+	},
+	"docs": {
+		description: "Generate rule docs and the rule index",
+		outputs:     []string{"docs"},
+		run:         runGenerateRules,
+	},
+	"catalog": {
+		description: "Generate the rule catalog",
+		outputs:     []string{"catalog"},
+		run:         runGenerateRules,
+	},
+	"all": {
+		description: "Generate rules, tests, docs and the catalog",
+		outputs:     []string{"rules", "tests", "docs", "catalog"},
+		run:         runGenerateRules,
+	},
+	"check": {
+		description: "Report drift of the mappings and generated files without writing, exit 1 when any is stale",
+		outputs:     []string{"rules", "tests", "docs", "catalog"},
+		run: func(bool) bool {
+			CheckOnly = true
+			staleMappings := generateMappings(false)
+			staleRules := generateRules()
+			if staleMappings > 0 {
+				fmt.Printf("\n❌ %d mapping files are stale, run apispec-gen mappings\n", staleMappings)
+			}
+			return staleMappings > 0 || staleRules
+		},
+	},
+}
+
+func runGenerateRules(bool) bool {
+	return generateRules()
+}
+
+// outputs are the kinds of generated files the running command writes
+var outputs = map[string]bool{}
+
+// outputKind returns whether a generated file is part of the "rules", "tests", "docs" or "catalog" output
+func outputKind(fileName string) string {
+	switch {
+	case strings.HasPrefix(fileName, DocsPath+"/"):
+		return "docs"
+	case strings.HasSuffix(fileName, "_test.go"):
+		return "tests"
+	case strings.HasPrefix(filepath.Base(fileName), "catalog."):
+		return "catalog"
+	}
+	return "rules"
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	name := os.Args[1]
+	cmd, ok := commands[name]
+	if !ok {
+		if name != "help" && name != "-h" && name != "-help" {
+			fmt.Printf("Unknown command %q\n\n", name)
+		}
+		usage()
+		os.Exit(2)
+	}
+
+	var configPath, specsPath, basePath, rulesPath, docsPath string
+	var skipExisting bool
+	flags := flag.NewFlagSet(name, flag.ExitOnError)
+	flags.StringVar(&configPath, "config", "", "Config file, default "+configFileName+" in the working directory or a parent")
+	flags.StringVar(&specsPath, "specs-path", "", "Path to fabric-rest-api-specs directory")
+	flags.StringVar(&basePath, "base-path", "", "Generator directory with mappings, templates and schema (default tools/apispec-gen)")
+	flags.StringVar(&rulesPath, "rules-path", "", "Output path for generated rules (default rules)")
+	flags.StringVar(&docsPath, "docs-path", "", "Output path for generated docs (default docs)")
+	if name == "mappings" {
+		flags.BoolVar(&skipExisting, "skip-existing", false, "Skip mapping files that already exist instead of merging updates")
+	}
+	flags.Parse(os.Args[2:])
+
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	SpecsPath = firstNonEmpty(specsPath, cfg.SpecsPath)
+	BasePath = firstNonEmpty(basePath, cfg.BasePath, "tools/apispec-gen")
+	RulesPath = firstNonEmpty(rulesPath, cfg.RulesPath, "rules")
+	DocsPath = firstNonEmpty(docsPath, cfg.DocsPath, "docs")
+
+	if SpecsPath == "" {
+		fmt.Println("Error: -specs-path is required, or specs_path in " + configFileName)
+		fmt.Printf("Usage: apispec-gen %s -specs-path /path/to/fabric-rest-api-specs\n", name)
+		os.Exit(1)
+	}
+
+	for _, kind := range cmd.outputs {
+		outputs[kind] = true
+	}
+	if cmd.run(skipExisting) {
+		os.Exit(1)
+	}
+}
+
+// loadConfig reads the config file at configPath, or the one found from the working directory when it is empty
+// Without a config file every path comes from the flags and defaults.
+func loadConfig(configPath string) (config, error) {
+	var cfg config
+	if configPath == "" {
+		configPath = findConfig()
+		if configPath == "" {
+			return cfg, nil
+		}
+	}
+
+	f, diags := hclparse.NewParser().ParseHCLFile(configPath)
+	if diags.HasErrors() {
+		return cfg, diags
+	}
+	if diags := gohcl.DecodeBody(f.Body, nil, &cfg); diags.HasErrors() {
+		return cfg, diags
+	}
+	fmt.Println("Using config:", configPath)
+
+	dir := filepath.Dir(configPath)
+	for _, p := range []*string{&cfg.SpecsPath, &cfg.BasePath, &cfg.RulesPath, &cfg.DocsPath} {
+		if *p != "" && !filepath.IsAbs(*p) {
+			*p = filepath.Join(dir, *p)
+		}
+	}
+	return cfg, nil
+}
+
+// findConfig returns the config file in the working directory or its closest parent that has one
+func findConfig() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	for {
+		candidate := filepath.Join(dir, configFileName)
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func usage() {
+	fmt.Println("Usage: apispec-gen <command> [flags]")
+	fmt.Println("\nCommands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Printf("  %-9s %s\n", name, commands[name].description)
+	}
+	fmt.Println("\nRun apispec-gen <command> -h for its flags.")
+}
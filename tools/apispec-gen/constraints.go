@@ -0,0 +1,425 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// constraintKinds lists the supported constraint kinds in the order they are documented
+var constraintKinds = []string{"one_of", "at_most_one_of", "required_with", "conflicts_with", "required_if", "forbidden_if"}
+
+type constraintMeta struct {
+	RuleName     string
+	RuleNameCC   string
+	ResourceType string
+	// BlockKind is the top-level block type, "resource" or "data"
+	BlockKind string
+	Kind      string
+	// Paths are the constrained attributes, dotted for nested objects, e.g. "target.onelake"
+	Paths         []string
+	Attribute     string
+	WhenAttribute string
+	WhenEquals    string
+	Message       string
+	Description   string
+	ReferenceURL  string
+	TestCases     []fixtureTestCase
+}
+
+// kind returns the single constraint kind set on c and its paths
+func (c constraint) kind() (string, []string, error) {
+	lists := map[string][]string{
+		"one_of":         c.OneOf,
+		"at_most_one_of": c.AtMostOneOf,
+		"required_with":  c.RequiredWith,
+		"conflicts_with": c.ConflictsWith,
+		"required_if":    c.RequiredIf,
+		"forbidden_if":   c.ForbiddenIf,
+	}
+
+	var kinds []string
+	for _, kind := range constraintKinds {
+		if len(lists[kind]) > 0 {
+			kinds = append(kinds, kind)
+		}
+	}
+	if len(kinds) != 1 {
+		return "", nil, fmt.Errorf("constraint %q must set exactly one of %s", c.Name, strings.Join(constraintKinds, ", "))
+	}
+	kind := kinds[0]
+
+	switch kind {
+	case "one_of", "at_most_one_of":
+		if len(lists[kind]) < 2 {
+			return "", nil, fmt.Errorf("constraint %q: %s needs at least two attributes", c.Name, kind)
+		}
+	case "required_with", "conflicts_with":
+		if c.Attribute == nil {
+			return "", nil, fmt.Errorf("constraint %q: %s needs attribute", c.Name, kind)
+		}
+	case "required_if", "forbidden_if":
+		if c.WhenAttribute == nil || c.WhenEquals == nil {
+			return "", nil, fmt.Errorf("constraint %q: %s needs when_attribute and when_equals", c.Name, kind)
+		}
+	}
+	return kind, lists[kind], nil
+}
+
+// processConstraints generates a rule, doc and test for each cross-attribute constraint of m and its data sources
+func processConstraints(m mapping) {
+	for _, c := range m.Constraints {
+		processConstraint(m, "resource", m.Resource, c)
+	}
+	for _, dataSource := range m.DataSources {
+		for _, c := range dataSource.Constraints {
+			processConstraint(m, "data", dataSource.Name, c)
+		}
+	}
+}
+
+// processConstraint generates the rule for one constraint of the resource or data source named name
+func processConstraint(m mapping, blockType, name string, c constraint) {
+	kind, paths, err := c.kind()
+	if err != nil {
+		fmt.Printf("  Skipping %s constraint: %v\n", name, err)
+		return
+	}
+
+	ruleName := fmt.Sprintf("%s_constraint_%s", name, c.Name)
+	if blockType == "data" {
+		ruleName = fmt.Sprintf("%s_data_source_constraint_%s", name, c.Name)
+	}
+	meta := &constraintMeta{
+		RuleName:     ruleName,
+		RuleNameCC:   toCamelCase(ruleName),
+		ResourceType: name,
+		BlockKind:    blockType,
+		Kind:         kind,
+		Paths:        paths,
+		ReferenceURL: fmt.Sprintf("https://github.com/microsoft/fabric-rest-api-specs/tree/main/%s", strings.TrimPrefix(m.ImportPath, "./")),
+	}
+	if c.Attribute != nil {
+		meta.Attribute = *c.Attribute
+	}
+	if c.WhenAttribute != nil {
+		meta.WhenAttribute = *c.WhenAttribute
+		meta.WhenEquals = *c.WhenEquals
+	}
+	if c.Message != nil {
+		meta.Message = *c.Message
+	}
+
+	for _, path := range meta.allPaths() {
+		if !schemaHasPath(blockType, name, path) {
+			fmt.Printf("  ⚠️  %s: %s.%s not found in schema.json\n", ruleName, name, path)
+		}
+	}
+
+	meta.Description = meta.describe()
+	meta.TestCases = meta.testCases()
+
+	generateFile(fmt.Sprintf("%s/apispec/nested.go", RulesPath), getFullPath("nested.go.tmpl"), nil)
+	generateFile(fmt.Sprintf("%s/apispec/constraints.go", RulesPath), getFullPath("constraints.go.tmpl"), nil)
+	generateFile(fmt.Sprintf("%s/apispec/%s.go", RulesPath, ruleName), getFullPath("rule_constraint.go.tmpl"), meta)
+	generateFile(fmt.Sprintf("%s/apispec/%s_test.go", RulesPath, ruleName), getFullPath("rule_test.go.tmpl"), meta)
+	generateFile(fmt.Sprintf("%s/rules/%s.md", DocsPath, ruleName), getFullPath("rule_constraint.md.tmpl"), meta)
+	catalogConstraintRule(m, c, meta)
+	generatedRuleNames = append(generatedRuleNames, meta.RuleName)
+	generatedRuleNameCCs = append(generatedRuleNameCCs, meta.RuleNameCC)
+}
+
+// processVariants generates a required_if constraint rule for each variant of a polymorphic API object
+// A variant requires its object, when the mapping names one, and the API-required properties that the provider
+// schema leaves optional. Variants without a Terraform counterpart are skipped.
+func processVariants(apiSpec apiSpec, m mapping, v variantsMapping) {
+	fmt.Printf("Generating variant rules for `%s` %s\n", m.Resource, v.Name)
+
+	schema, ok := lookupApiRef(apiSpec, m, "variants."+v.Name, v.ApiRef)
+	if !ok {
+		return
+	}
+	variants := specs.variants(schema)
+	if len(variants) == 0 {
+		fmt.Printf("  ⚠️  %s.%s: %s has no oneOf, anyOf or discriminator variants\n", m.Resource, v.Name, v.ApiRef)
+		return
+	}
+	property := discriminatorProperty(schema)
+	parent := ""
+	if i := strings.LastIndex(v.Discriminator, "."); i >= 0 {
+		parent = v.Discriminator[:i+1]
+	}
+
+	for _, variant := range variants {
+		if variant.value == "" {
+			fmt.Printf("  ⚠️  %s.%s: skipping variant %s without discriminator value\n", m.Resource, v.Name, variant.schema.loc.pointer)
+			continue
+		}
+		name := toSnakeCase(variant.value)
+		prefix := parent
+		var paths []string
+		if v.Object != nil {
+			object := strings.ReplaceAll(*v.Object, "{variant}", name)
+			if !schemaHasPath("resource", m.Resource, object) {
+				fmt.Printf("  ℹ️  %s.%s: variant %s has no %s in schema.json, skipping\n", m.Resource, v.Name, variant.value, object)
+				continue
+			}
+			paths = append(paths, object)
+			prefix = object + "."
+		}
+
+		required, _ := variant.schema.keywords["required"].([]interface{})
+		for _, item := range required {
+			apiName, _ := item.(string)
+			if apiName == "" || apiName == property {
+				continue
+			}
+			path := prefix + toSnakeCase(apiName)
+			attr, ok := schemaAttribute("resource", m.Resource, path)
+			if !ok {
+				fmt.Printf("  ℹ️  %s.%s: %s of variant %s is not in schema.json, skipping\n", m.Resource, v.Name, path, variant.value)
+				continue
+			}
+			// Required attributes of the object are already enforced by Terraform once the object is set
+			if !attr.Required {
+				paths = append(paths, path)
+			}
+		}
+		if len(paths) == 0 {
+			continue
+		}
+
+		value, source := variant.value, variant.schema.loc
+		processConstraint(m, "resource", m.Resource, constraint{
+			Name:          v.Name + "_" + name,
+			RequiredIf:    paths,
+			WhenAttribute: &v.Discriminator,
+			WhenEquals:    &value,
+			source:        &source,
+			block:         "variants." + v.Name,
+		})
+	}
+}
+
+// allPaths returns every attribute path the constraint reads
+func (m *constraintMeta) allPaths() []string {
+	paths := append([]string{}, m.Paths...)
+	if m.Attribute != "" {
+		paths = append(paths, m.Attribute)
+	}
+	if m.WhenAttribute != "" {
+		paths = append(paths, m.WhenAttribute)
+	}
+	return paths
+}
+
+// describe returns the constraint in plain words for the rule doc
+func (m *constraintMeta) describe() string {
+	quoted := make([]string, 0, len(m.Paths))
+	for _, path := range m.Paths {
+		quoted = append(quoted, fmt.Sprintf("`%s`", path))
+	}
+	list := strings.Join(quoted, ", ")
+
+	switch m.Kind {
+	case "one_of":
+		return fmt.Sprintf("Exactly one of %s must be set", list)
+	case "at_most_one_of":
+		return fmt.Sprintf("Only one of %s can be set", list)
+	case "required_with":
+		return fmt.Sprintf("%s must be set when `%s` is set", list, m.Attribute)
+	case "conflicts_with":
+		return fmt.Sprintf("%s cannot be set together with `%s`", list, m.Attribute)
+	case "required_if":
+		return fmt.Sprintf("%s must be set when `%s` is `%q`", list, m.WhenAttribute, m.WhenEquals)
+	default:
+		return fmt.Sprintf("%s cannot be set when `%s` is `%q`", list, m.WhenAttribute, m.WhenEquals)
+	}
+}
+
+// expectedIssues returns the issues the generated rule reports for f, rendered from values
+// It mirrors the check helpers in rules/apispec/constraints.go
+func (m *constraintMeta) expectedIssues(f fixture, values map[string]string) []fixtureIssue {
+	var issues []fixtureIssue
+	// An empty path reports at the block, like a one_of with no attribute set
+	add := func(message, path string) {
+		rng, ok := f.ranges[path]
+		if !ok {
+			rng = f.defRange
+		}
+		issues = append(issues, fixtureIssue{Message: message, Range: rng})
+	}
+
+	var set []string
+	for _, path := range m.Paths {
+		if isSet(values, path) {
+			set = append(set, path)
+		}
+	}
+	list := strings.Join(m.Paths, ", ")
+	matches := m.WhenAttribute != "" && values[m.WhenAttribute] == fmt.Sprintf("%q", m.WhenEquals)
+
+	switch m.Kind {
+	case "one_of":
+		if len(set) > 1 {
+			add(fmt.Sprintf("Exactly one of %s must be set", list), set[1])
+		} else if len(set) == 0 {
+			add(fmt.Sprintf("Exactly one of %s must be set", list), "")
+		}
+	case "at_most_one_of":
+		if len(set) > 1 {
+			add(fmt.Sprintf("Only one of %s can be set", list), set[1])
+		}
+	case "required_with":
+		if isSet(values, m.Attribute) {
+			for _, path := range m.Paths {
+				if !isSet(values, path) {
+					add(fmt.Sprintf("%s is required when %s is set", path, m.Attribute), m.Attribute)
+				}
+			}
+		}
+	case "conflicts_with":
+		if isSet(values, m.Attribute) {
+			for _, path := range set {
+				add(fmt.Sprintf("%s cannot be set together with %s", path, m.Attribute), path)
+			}
+		}
+	case "required_if":
+		if matches {
+			for _, path := range m.Paths {
+				if !isSet(values, path) {
+					add(fmt.Sprintf("%s is required when %s is %q", path, m.WhenAttribute, m.WhenEquals), m.WhenAttribute)
+				}
+			}
+		}
+	case "forbidden_if":
+		if matches {
+			for _, path := range set {
+				add(fmt.Sprintf("%s cannot be set when %s is %q", path, m.WhenAttribute, m.WhenEquals), path)
+			}
+		}
+	}
+
+	if len(issues) > 0 && m.Message != "" {
+		issues = []fixtureIssue{{Message: m.Message, Range: issues[0].Range}}
+	}
+	return issues
+}
+
+// testCases derives valid and invalid configurations from the constraint
+func (m *constraintMeta) testCases() []fixtureTestCase {
+	first := m.Paths[0]
+	when := func(value string, paths ...string) map[string]string {
+		values := map[string]string{m.WhenAttribute: fmt.Sprintf("%q", value)}
+		for _, path := range paths {
+			values[path] = ""
+		}
+		return values
+	}
+	set := func(paths ...string) map[string]string {
+		values := make(map[string]string, len(paths))
+		for _, path := range paths {
+			values[path] = ""
+		}
+		return values
+	}
+
+	var cases []fixtureTestCase
+	add := func(name string, values map[string]string) {
+		f := renderFixture(m.BlockKind, m.ResourceType, values)
+		cases = append(cases, fixtureTestCase{Name: name, Content: f.content, Expected: m.expectedIssues(f, values)})
+	}
+
+	switch m.Kind {
+	case "one_of":
+		add(fmt.Sprintf("only %s set", first), set(first))
+		add("none set", set())
+		add(fmt.Sprintf("%s and %s set", first, m.Paths[1]), set(first, m.Paths[1]))
+	case "at_most_one_of":
+		add("none set", set())
+		add(fmt.Sprintf("only %s set", first), set(first))
+		add(fmt.Sprintf("%s and %s set", first, m.Paths[1]), set(first, m.Paths[1]))
+	case "required_with":
+		add(fmt.Sprintf("%s set with all required attributes", m.Attribute), set(append([]string{m.Attribute}, m.Paths...)...))
+		add(fmt.Sprintf("%s not set", m.Attribute), set())
+		add(fmt.Sprintf("%s set alone", m.Attribute), set(m.Attribute))
+	case "conflicts_with":
+		add(fmt.Sprintf("only %s set", m.Attribute), set(m.Attribute))
+		add(fmt.Sprintf("only %s set", first), set(first))
+		add(fmt.Sprintf("%s and %s set", m.Attribute, first), set(m.Attribute, first))
+	case "required_if":
+		add(fmt.Sprintf("%s is %s with all required attributes", m.WhenAttribute, m.WhenEquals), when(m.WhenEquals, m.Paths...))
+		add(fmt.Sprintf("%s is another value", m.WhenAttribute), when("not-"+m.WhenEquals))
+		add(fmt.Sprintf("%s is %s without required attributes", m.WhenAttribute, m.WhenEquals), when(m.WhenEquals))
+	case "forbidden_if":
+		add(fmt.Sprintf("%s is %s without forbidden attributes", m.WhenAttribute, m.WhenEquals), when(m.WhenEquals))
+		add(fmt.Sprintf("%s is another value", m.WhenAttribute), when("not-"+m.WhenEquals, first))
+		add(fmt.Sprintf("%s is %s with %s", m.WhenAttribute, m.WhenEquals, first), when(m.WhenEquals, first))
+	}
+	return cases
+}
+
+// sampleValue returns an HCL literal matching the schema.json type of path
+func sampleValue(blockType, name, path string) string {
+	attr, ok := schemaAttribute(blockType, name, path)
+	if !ok {
+		return `"example"`
+	}
+	if attr.NestedType != nil {
+		return "{}"
+	}
+	switch attr.Type {
+	case "number":
+		return "1"
+	case "bool":
+		return "true"
+	default:
+		return `"example"`
+	}
+}
+
+// schemaHasPath reports whether path is an attribute or nested block of the resource or data source in schema.json
+func schemaHasPath(blockType, name, path string) bool {
+	if _, ok := schemaAttribute(blockType, name, path); ok {
+		return true
+	}
+	current, ok := terraformSchema.schemaOf(blockType, name)
+	if !ok {
+		return false
+	}
+	for _, name := range strings.Split(path, ".") {
+		if current, ok = current.Block.BlockTypes[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// schemaAttribute returns the schema.json attribute at the dotted path of a resource, or of a data source
+// when blockType is "data"; path segments before the last may be nested blocks or nested attributes
+func schemaAttribute(blockType, name, path string) (attribute, bool) {
+	rs, ok := terraformSchema.schemaOf(blockType, name)
+	if !ok {
+		return attribute{}, false
+	}
+	names := strings.Split(path, ".")
+	attrs, blocks := rs.Block.Attributes, rs.Block.BlockTypes
+	for i, name := range names {
+		attr, ok := attrs[name]
+		if !ok {
+			block, isBlock := blocks[name]
+			if !isBlock || i == len(names)-1 {
+				return attribute{}, false
+			}
+			attrs, blocks = block.Block.Attributes, block.Block.BlockTypes
+			continue
+		}
+		if i == len(names)-1 {
+			return attr, true
+		}
+		if attr.NestedType == nil {
+			return attribute{}, false
+		}
+		attrs, blocks = attr.NestedType.Attributes, nil
+	}
+	return attribute{}, false
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// With the check command the generator runs as usual but writeFile only records what would change, so CI can tell
+// when the API specs or schema.json moved on without anyone regenerating the rules.
+
+var CheckOnly bool
+
+// unresolvedRefs are api_refs that no longer resolve in the API specs, as "resource.attribute: reason"
+var unresolvedRefs []string
+
+// unresolvedRef warns that the api_ref of the mapping block name of resource cannot be resolved and records it for
+// the drift report
+func unresolvedRef(resource, name string, format string, args ...any) {
+	reason := fmt.Sprintf(format, args...)
+	fmt.Printf("Warning: %s for %s.%s\n", reason, resource, name)
+	unresolvedRefs = append(unresolvedRefs, fmt.Sprintf("%s.%s: %s", resource, name, reason))
+}
+
+// enumMismatches are attributes whose valid_values differ from the enum of the API spec, as "resource.attribute: values"
+var enumMismatches []string
+
+// compareEnums records the values that the valid_values of ref and the enum of its api_ref don't have in common, so
+// the drift report shows when a hand-written enum falls behind the spec
+func compareEnums(ref attributeRef, specEnum, validValues []string) {
+	var missing, extra []string
+	for _, value := range specEnum {
+		if !contains(validValues, value) {
+			missing = append(missing, value)
+		}
+	}
+	for _, value := range validValues {
+		if !contains(specEnum, value) {
+			extra = append(extra, value)
+		}
+	}
+
+	var differences []string
+	if len(missing) > 0 {
+		differences = append(differences, fmt.Sprintf("spec adds %s", strings.Join(missing, ", ")))
+	}
+	if len(extra) > 0 {
+		differences = append(differences, fmt.Sprintf("spec lacks %s", strings.Join(extra, ", ")))
+	}
+	if len(differences) > 0 {
+		enumMismatches = append(enumMismatches, fmt.Sprintf("%s.%s: %s", ref.resource, ref.path(), strings.Join(differences, "; ")))
+	}
+}
+
+// readCatalog returns the entries of the catalog.json written by the previous run, if any
+func readCatalog() []catalogEntry {
+	content, err := os.ReadFile(fmt.Sprintf("%s/apispec/catalog.json", RulesPath))
+	if err != nil {
+		return nil
+	}
+	var catalog struct {
+		Rules []catalogEntry `json:"rules"`
+	}
+	if err := json.Unmarshal(content, &catalog); err != nil {
+		fmt.Printf("Warning: Could not parse previous catalog.json: %v\n", err)
+		return nil
+	}
+	return catalog.Rules
+}
+
+// catalogChanges describes the rules added, removed or with changed constraints between two catalogs
+func catalogChanges(previous, current []catalogEntry) []string {
+	constraintsOf := func(entries []catalogEntry) map[string]map[string]string {
+		rules := make(map[string]map[string]string, len(entries))
+		for _, entry := range entries {
+			constraints := make(map[string]string, len(entry.Constraints))
+			for _, c := range entry.Constraints {
+				value, _ := json.Marshal(c.Value)
+				constraints[c.Type] = string(value)
+			}
+			rules[entry.Name] = constraints
+		}
+		return rules
+	}
+	before, after := constraintsOf(previous), constraintsOf(current)
+
+	var changes []string
+	for name, constraints := range after {
+		old, ok := before[name]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("%s: new rule", name))
+			continue
+		}
+		for kind, value := range constraints {
+			if oldValue, ok := old[kind]; !ok {
+				changes = append(changes, fmt.Sprintf("%s: %s added (%s)", name, kind, value))
+			} else if oldValue != value {
+				changes = append(changes, fmt.Sprintf("%s: %s changed from %s to %s", name, kind, oldValue, value))
+			}
+		}
+		for kind, value := range old {
+			if _, ok := constraints[kind]; !ok {
+				changes = append(changes, fmt.Sprintf("%s: %s removed (was %s)", name, kind, value))
+			}
+		}
+	}
+	for name := range before {
+		if _, ok := after[name]; !ok {
+			changes = append(changes, fmt.Sprintf("%s: rule no longer generated", name))
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
+
+// unmappedSchemaAttributes returns the attributes of mapped resources that schema.json documents a max length or
+// enum for, but that have no attribute mapping
+func unmappedSchemaAttributes(mappings []mapping) []string {
+	var unmapped []string
+	for _, m := range mappings {
+		mapped := make(map[string]bool, len(m.Attributes))
+		for _, attr := range m.Attributes {
+			mapped[attr.Name] = true
+		}
+
+		found := make(map[string]bool)
+		for path := range schemaConstraints[m.Resource] {
+			found[path] = true
+		}
+		for path := range schemaEnums[m.Resource] {
+			found[path] = true
+		}
+		for path := range found {
+			if !mapped[path] {
+				unmapped = append(unmapped, fmt.Sprintf("%s.%s", m.Resource, path))
+			}
+		}
+	}
+	sort.Strings(unmapped)
+	return unmapped
+}
+
+// reportDrift prints the drift report of the check command and returns whether the generated files are stale
+func reportDrift(changes, unmappedAttributes, pending, unmappedResources []string) bool {
+	var stale []string
+	for _, c := range changeLog {
+		if c.Status != "skipped" {
+			stale = append(stale, fmt.Sprintf("%s (%s)", c.Path, c.Status))
+		}
+	}
+
+	sections := []struct {
+		title string
+		lines []string
+	}{
+		{"api_refs that no longer resolve", unresolvedRefs},
+		{"valid_values that differ from the API spec enum", enumMismatches},
+		{"Changed constraints", changes},
+		{"schema.json attributes with constraints but no mapping", unmappedAttributes},
+		{"Pending mappings (resource not in schema.json)", pending},
+		{"Provider resources without a mapping", unmappedResources},
+		{"Stale generated files", stale},
+	}
+
+	fmt.Printf("\n=== Drift check ===\n")
+	for _, section := range sections {
+		fmt.Printf("%s: %d\n", section.title, len(section.lines))
+		for _, line := range section.lines {
+			fmt.Printf("  - %s\n", line)
+		}
+	}
+	if len(stale) > 0 {
+		fmt.Println("\n❌ Generated output is stale, run apispec-gen all")
+	} else {
+		fmt.Println("\n✅ Generated output is up to date")
+	}
+	fmt.Println(strings.Repeat("=", 32))
+	return len(stale) > 0
+}
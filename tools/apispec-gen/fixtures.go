@@ -0,0 +1,308 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Generated tests run each rule against small configurations ("fixtures") and assert the message and range of
+// every issue. The expected issues are derived from the same checks the rule templates generate.
+
+type fixtureTestCase struct {
+	Name     string
+	Content  string
+	Expected []fixtureIssue
+}
+
+type fixtureIssue struct {
+	Message string
+	Range   fixtureRange
+}
+
+// fixtureRange is an hcl.Range in main.tf, the file name used by the generated tests
+type fixtureRange struct {
+	StartLine   int
+	StartColumn int
+	EndLine     int
+	EndColumn   int
+}
+
+// fixture is a rendered configuration and the range of every attribute path it sets
+type fixture struct {
+	content  string
+	defRange fixtureRange
+	ranges   map[string]fixtureRange
+}
+
+// renderFixture writes a resource or data block with the given attribute paths set to HCL literals
+// Nested paths are written as object attributes, e.g. target = { onelake = {} }, and an empty value is replaced by
+// a sample. Ranges are those of the content as embedded in the generated tests, which start with a newline.
+func renderFixture(blockType, name string, values map[string]string) fixture {
+	type node struct {
+		value    string
+		children map[string]*node
+	}
+	root := &node{children: map[string]*node{}}
+	for path, value := range values {
+		current := root
+		for _, segment := range strings.Split(path, ".") {
+			child, exists := current.children[segment]
+			if !exists {
+				child = &node{children: map[string]*node{}}
+				current.children[segment] = child
+			}
+			current = child
+		}
+		if value == "" {
+			value = sampleValue(blockType, name, path)
+		}
+		current.value = value
+	}
+
+	header := fmt.Sprintf("%s %q \"example\"", blockType, name)
+	lines := []string{header + " {"}
+	f := fixture{
+		defRange: fixtureRange{StartLine: 2, StartColumn: 1, EndLine: 2, EndColumn: len(header) + 1},
+		ranges:   map[string]fixtureRange{},
+	}
+
+	var write func(n *node, prefix, indent string)
+	write = func(n *node, prefix, indent string) {
+		names := make([]string, 0, len(n.children))
+		for name := range n.children {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			child := n.children[name]
+			start := fixtureRange{StartLine: len(lines) + 2, StartColumn: len(indent+name+" = ") + 1}
+			if len(child.children) == 0 {
+				lines = append(lines, fmt.Sprintf("%s%s = %s", indent, name, child.value))
+				start.EndLine, start.EndColumn = start.StartLine, start.StartColumn+len(child.value)
+				f.ranges[prefix+name] = start
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("%s%s = {", indent, name))
+			write(child, prefix+name+".", indent+"  ")
+			lines = append(lines, indent+"}")
+			start.EndLine, start.EndColumn = len(lines)+1, len(indent)+2
+			f.ranges[prefix+name] = start
+		}
+	}
+	write(root, "", "  ")
+
+	lines = append(lines, "}")
+	f.content = strings.Join(lines, "\n")
+	return f
+}
+
+// isSet reports whether path, or an attribute inside it, is set in values
+func isSet(values map[string]string, path string) bool {
+	for key := range values {
+		if key == path || strings.HasPrefix(key, path+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// hclString returns s as an HCL string literal
+func hclString(s string) string {
+	return strconv.Quote(s)
+}
+
+// sampleStrings are tried in order as valid values for rules without an enum
+var sampleStrings = []string{
+	"example",
+	"Example",
+	"example_1",
+	"a",
+	"00000000-0000-0000-0000-000000000000",
+	"https://example.com",
+}
+
+// invalidPatternSamples are tried in order as values that fail a pattern
+var invalidPatternSamples = []string{
+	"1example",
+	"example value!",
+	"-example",
+	"_example",
+	"/example",
+	"example/",
+	" ",
+}
+
+// paddingCharacters are appended to a valid value to reach a length boundary
+var paddingCharacters = []string{"a", "0", "_", "-", "x"}
+
+// issues returns the messages the generated rule reports for v, in the order the template checks them
+func (m *ruleMeta) issues(v string) []string {
+	var messages []string
+	if m.SetMaxLength && len(v) > m.MaxLength {
+		messages = append(messages, m.message(fmt.Sprintf("%s exceeds max length %d", m.AttributePath, m.MaxLength), v, m.MaxLength, len(v)))
+	}
+	if m.SetMinLength && len(v) < m.MinLength {
+		messages = append(messages, m.message(fmt.Sprintf("%s shorter than min length %d", m.AttributePath, m.MinLength), v, m.MinLength, len(v)))
+	}
+	if len(m.Enum) > 0 && !contains(m.Enum, v) {
+		allowed := strings.Join(m.Enum, ", ")
+		messages = append(messages, m.message(fmt.Sprintf("%q is an invalid value as %s, must be one of: %s", v, m.AttributePath, allowed), v, allowed, v))
+	}
+	if m.Pattern != "" && !regexp.MustCompile(m.Pattern).MatchString(v) {
+		description := "must match the pattern " + m.Pattern
+		if m.PatternMessage != "" {
+			description = m.PatternMessage
+		}
+		message := m.message(fmt.Sprintf("%s %q %s", m.AttributePath, v, description), v, m.Pattern, v)
+		if m.AllowedCharacters != "" {
+			allowed := regexp.MustCompile("^[" + m.AllowedCharacters + "]$")
+			var invalid []string
+			seen := make(map[rune]bool)
+			for _, c := range v {
+				if !seen[c] && !allowed.MatchString(string(c)) {
+					seen[c] = true
+					invalid = append(invalid, fmt.Sprintf("%q", c))
+				}
+			}
+			if len(invalid) > 0 {
+				message = fmt.Sprintf("%s (invalid characters: %s)", message, strings.Join(invalid, ", "))
+			}
+		}
+		messages = append(messages, message)
+	}
+	for _, reserved := range m.ReservedWords {
+		if strings.EqualFold(v, reserved) {
+			messages = append(messages, m.message(fmt.Sprintf("%q is a reserved name and cannot be used as %s", v, m.AttributePath), v, reserved, v))
+			break
+		}
+	}
+	return messages
+}
+
+// message returns the default message of a check, or the message of the mapping filled in like issueMessage does
+func (m *ruleMeta) message(defaultMessage, v string, limit, actual any) string {
+	if m.Message == "" {
+		return defaultMessage
+	}
+	return strings.NewReplacer("{value}", v, "{limit}", fmt.Sprint(limit), "{actual}", fmt.Sprint(actual)).Replace(m.Message)
+}
+
+// withLength returns base truncated or padded to n characters, preferring the padding that passes the most checks
+func (m *ruleMeta) withLength(base string, n int) string {
+	if n <= len(base) {
+		return base[:n]
+	}
+	best, bestIssues := "", -1
+	for _, padding := range paddingCharacters {
+		v := base + strings.Repeat(padding, n-len(base))
+		if count := len(m.issues(v)); bestIssues == -1 || count < bestIssues {
+			best, bestIssues = v, count
+		}
+	}
+	return best
+}
+
+// validValue returns a value that passes every check of the rule
+func (m *ruleMeta) validValue() (string, bool) {
+	candidates := m.Enum
+	if len(candidates) == 0 {
+		candidates = sampleStrings
+	}
+	for _, candidate := range candidates {
+		v := candidate
+		if m.SetMinLength && len(v) < m.MinLength {
+			v = m.withLength(v, m.MinLength)
+		}
+		if m.SetMaxLength && len(v) > m.MaxLength {
+			v = m.withLength(v, m.MaxLength)
+		}
+		if len(m.issues(v)) == 0 {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// testCases derives a valid fixture, boundary fixtures and an invalid fixture per check of the rule
+func (m *ruleMeta) testCases() []fixtureTestCase {
+	var cases []fixtureTestCase
+	add := func(name, v string) {
+		f := renderFixture(m.BlockKind, m.ResourceType, map[string]string{m.AttributePath: hclString(v)})
+		c := fixtureTestCase{Name: name, Content: f.content}
+		for _, message := range m.issues(v) {
+			c.Expected = append(c.Expected, fixtureIssue{Message: message, Range: f.ranges[m.AttributePath]})
+		}
+		cases = append(cases, c)
+	}
+
+	valid, ok := m.validValue()
+	if !ok {
+		fmt.Printf("  ⚠️  %s: no valid test value found, the generated test only covers invalid values\n", m.RuleName)
+	}
+
+	// Valid and boundary values
+	if len(m.Enum) > 0 {
+		for _, e := range m.Enum {
+			if len(m.issues(e)) == 0 {
+				add(fmt.Sprintf("enum value %s", e), e)
+			}
+		}
+	} else if ok {
+		add("valid value", valid)
+	}
+	if ok && m.SetMaxLength && len(m.Enum) == 0 {
+		if v := m.withLength(valid, m.MaxLength); len(m.issues(v)) == 0 {
+			add(fmt.Sprintf("exactly max length %d", m.MaxLength), v)
+		}
+	}
+	if ok && m.SetMinLength && len(m.Enum) == 0 {
+		if v := m.withLength(valid, m.MinLength); len(m.issues(v)) == 0 {
+			add(fmt.Sprintf("exactly min length %d", m.MinLength), v)
+		}
+	}
+
+	// Invalid values
+	if m.SetMaxLength {
+		add(fmt.Sprintf("exceeds max length %d", m.MaxLength), m.withLength(valid, m.MaxLength+1))
+	}
+	if m.SetMinLength && m.MinLength > 0 {
+		add(fmt.Sprintf("shorter than min length %d", m.MinLength), m.withLength(valid, m.MinLength-1))
+	}
+	if len(m.Enum) > 0 {
+		invalid := "invalid"
+		for contains(m.Enum, invalid) {
+			invalid = "not-" + invalid
+		}
+		add("value not in enum", invalid)
+	}
+	if m.Pattern != "" {
+		pattern := regexp.MustCompile(m.Pattern)
+		found := false
+		for _, v := range invalidPatternSamples {
+			if !pattern.MatchString(v) && (!m.SetMaxLength || len(v) <= m.MaxLength) && (!m.SetMinLength || len(v) >= m.MinLength) {
+				add("value not matching pattern", v)
+				found = true
+				break
+			}
+		}
+		if !found {
+			fmt.Printf("  ⚠️  %s: no value found that fails pattern %s\n", m.RuleName, m.Pattern)
+		}
+	}
+	if len(m.ReservedWords) > 0 {
+		add(fmt.Sprintf("reserved word %s", m.ReservedWords[0]), m.ReservedWords[0])
+	}
+	return cases
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,1348 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"go/format"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclparse"
+)
+
+type mappingFile struct {
+	Mappings []mapping `hcl:"mapping,block"`
+}
+
+type mapping struct {
+	Resource    string              `hcl:"resource,label"`
+	ImportPath  string              `hcl:"import_path"`
+	Attributes  []attributeMapping  `hcl:"attribute,block"`
+	Constraints []constraint        `hcl:"constraint,block"`
+	DataSources []dataSourceMapping `hcl:"data_source,block"`
+	Variants    []variantsMapping   `hcl:"variants,block"`
+
+	// file is the mapping file relative to -base-path, e.g. "mappings/fabric_lakehouse.hcl"
+	file string
+	// manualComments are the "// MANUAL:" comments above each block, see manualComments
+	manualComments map[string][]string
+}
+
+// dataSourceMapping generates rules for a data source whose lookup arguments take the same values as the resource,
+// e.g. data "fabric_lakehouse" looks lakehouses up by display_name
+type dataSourceMapping struct {
+	Name string `hcl:"name,label"`
+	// Attributes are names of attribute mappings of the resource that are also data source arguments
+	Attributes  []string     `hcl:"attributes,optional"`
+	Constraints []constraint `hcl:"constraint,block"`
+}
+
+type attributeMapping struct {
+	Name         string   `hcl:"name,label"`
+	ApiRef       string   `hcl:"api_ref"`
+	MaxLength    *int     `hcl:"max_length,optional"`
+	MinLength    *int     `hcl:"min_length,optional"`
+	Pattern      *string  `hcl:"pattern,optional"`
+	WarnOnExceed *bool    `hcl:"warn_on_exceed,optional"`
+	ValidValues  []string `hcl:"valid_values,optional"`
+	KeyPattern   *string  `hcl:"key_pattern,optional"`
+	TypeHint     *string  `hcl:"type_hint,optional"` // e.g. "uuid", "uri", "bool"
+	ReadOnly     *bool    `hcl:"read_only,optional"` // force skip if true
+
+	PatternMessage    *string  `hcl:"pattern_message,optional"`    // e.g. "must start with a letter"
+	AllowedCharacters *string  `hcl:"allowed_characters,optional"` // regex character class body, e.g. "a-zA-Z0-9_"
+	ReservedWords     []string `hcl:"reserved_words,optional"`     // names rejected regardless of case
+
+	// Rule settings, the generated rule is an enabled error linking to the API spec by default
+	Severity *string `hcl:"severity,optional"` // "error", "warning" or "notice"
+	Enabled  *bool   `hcl:"enabled,optional"`  // false leaves the rule off until enabled in .tflint.hcl
+	Message  *string `hcl:"message,optional"`  // replaces the issue messages, see issueMessage
+	Link     *string `hcl:"link,optional"`     // replaces the link to the API spec
+
+	Shared    *string `hcl:"shared,optional"`     // name of a shared block of mappings/shared/ with more constraints
+	ManualDoc *bool   `hcl:"manual_doc,optional"` // true keeps the hand-written docs of the rules instead of generating them
+}
+
+// constraint is a cross-attribute rule; exactly one kind (one_of, at_most_one_of, ...) must be set
+// Paths may point into nested objects, e.g. "credential_details.basic_credentials"
+type constraint struct {
+	Name          string   `hcl:"name,label"`
+	OneOf         []string `hcl:"one_of,optional"`
+	AtMostOneOf   []string `hcl:"at_most_one_of,optional"`
+	RequiredWith  []string `hcl:"required_with,optional"`  // required when attribute is set
+	ConflictsWith []string `hcl:"conflicts_with,optional"` // forbidden when attribute is set
+	RequiredIf    []string `hcl:"required_if,optional"`    // required when when_attribute equals when_equals
+	ForbiddenIf   []string `hcl:"forbidden_if,optional"`   // forbidden when when_attribute equals when_equals
+	Attribute     *string  `hcl:"attribute,optional"`
+	WhenAttribute *string  `hcl:"when_attribute,optional"`
+	WhenEquals    *string  `hcl:"when_equals,optional"`
+	Message       *string  `hcl:"message,optional"` // replaces the default issue message
+
+	// source is the API schema of constraints generated from a variants block, nil for constraint blocks
+	source *specLocation
+	// block is the mapping block a generated constraint comes from, e.g. "variants.credential_type"
+	block string
+}
+
+// variantsMapping generates a required_if rule per variant of a polymorphic API object, selected by a discriminator
+// e.g. the credentials of a connection, where credential_type "Basic" selects BasicCredentials
+type variantsMapping struct {
+	Name   string `hcl:"name,label"`
+	ApiRef string `hcl:"api_ref"`
+	// Discriminator is the Terraform path of the attribute selecting the variant, e.g. "credential_details.credential_type"
+	Discriminator string `hcl:"discriminator"`
+	// Object is the Terraform path of the object holding the properties of a variant, "{variant}" standing for the
+	// snake_case discriminator value, e.g. "credential_details.{variant}_credentials"
+	// Without it the properties of every variant are siblings of the discriminator.
+	Object *string `hcl:"object,optional"`
+}
+
+// manualConstraint represents manually-added constraints in mapping files
+type manualConstraint struct {
+	MaxLength    *int     `hcl:"max_length,optional"`
+	MinLength    *int     `hcl:"min_length,optional"`
+	Pattern      *string  `hcl:"pattern,optional"`
+	WarnOnExceed *bool    `hcl:"warn_on_exceed,optional"`
+	ValidValues  []string `hcl:"valid_values,optional"`
+	KeyPattern   *string  `hcl:"key_pattern,optional"`
+	TypeHint     *string  `hcl:"type_hint,optional"`
+	ReadOnly     *bool    `hcl:"read_only,optional"`
+
+	PatternMessage    *string  `hcl:"pattern_message,optional"`
+	AllowedCharacters *string  `hcl:"allowed_characters,optional"`
+	ReservedWords     []string `hcl:"reserved_words,optional"`
+
+	Severity *string `hcl:"severity,optional"`
+	Enabled  *bool   `hcl:"enabled,optional"`
+	Message  *string `hcl:"message,optional"`
+	Link     *string `hcl:"link,optional"`
+
+	ManualDoc *bool `hcl:"manual_doc,optional"`
+}
+
+// ruleSeverities are the severity values of mappings and the tflint.Severity constants they generate
+var ruleSeverities = map[string]string{
+	"error":   "ERROR",
+	"warning": "WARNING",
+	"notice":  "NOTICE",
+}
+
+type apiSpec struct {
+	// file is the spec file relative to -specs-path, e.g. "lakehouse/definitions.json"
+	file string
+	// pointers are the JSON pointers of the Swagger 2 definitions and OpenAPI 3 components/schemas by name,
+	// e.g. "/definitions/CreateLakehouseRequest"
+	pointers map[string]string
+}
+
+type attributeRef struct {
+	resource string
+	// dataSource is set when resource names a data source rather than a resource
+	dataSource bool
+	// blocks are the nested blocks or objects that contain attribute, outermost first
+	blocks    []string
+	attribute string
+	value     hcl.Expression
+}
+
+type ChangeRecord struct {
+	Path   string
+	Status string // "created", "updated", "skipped"
+	Diff   string // for "updated" files
+}
+
+var changeLog []ChangeRecord
+
+func (r *attributeRef) String() string {
+	if r.dataSource {
+		return fmt.Sprintf("data.%s.%s", r.resource, r.path())
+	}
+	return fmt.Sprintf("%s.%s", r.resource, r.path())
+}
+
+// blockType returns the top-level block type of the attribute, "resource" or "data"
+func (r *attributeRef) blockType() string {
+	if r.dataSource {
+		return "data"
+	}
+	return "resource"
+}
+
+// path returns the dotted attribute path within the resource, e.g. "git_provider_details.repository_name"
+func (r *attributeRef) path() string {
+	return strings.Join(append(append([]string{}, r.blocks...), r.attribute), ".")
+}
+
+func (r *attributeRef) RuleName() string {
+	// resource already has "fabric_" prefix, don't add it again
+	prefix := r.resource
+	if r.dataSource {
+		prefix += "_data_source"
+	}
+	if len(r.blocks) > 0 {
+		return fmt.Sprintf("%s_%s_invalid_%s", prefix, strings.Join(r.blocks, "_"), r.attribute)
+	}
+	return fmt.Sprintf("%s_invalid_%s", prefix, r.attribute)
+}
+
+type ruleMeta struct {
+	RuleName     string
+	RuleNameCC   string
+	ResourceType string
+	// BlockKind is the top-level block type, "resource" or "data"
+	BlockKind string
+	// BlockType is the dotted path of the blocks containing the attribute, e.g. "target.onelake"
+	BlockType string
+	// BlockPath is BlockType split into block names
+	BlockPath     []string
+	AttributeName string
+	// AttributePath is the dotted path used in messages, e.g. "target.onelake.path"
+	AttributePath string
+	Sensitive     bool
+	Max           int
+	SetMax        bool
+	Min           int
+	SetMin        bool
+	MaxLength     int
+	SetMaxLength  bool
+	MinLength     int
+	SetMinLength  bool
+	Pattern       string
+	// PatternMessage describes Pattern in plain words, e.g. "must start with a letter"
+	PatternMessage string
+	// AllowedCharacters is a regex character class body used to list offending characters
+	AllowedCharacters string
+	ReservedWords     []string
+	Enum              []string
+	Format            string
+	ReadOnly          bool
+	WarnOnExceed      bool
+	// Severity is the tflint.Severity constant of the rule, e.g. "ERROR"
+	Severity string
+	Enabled  bool
+	// Message replaces the issue messages, its placeholders filled in by issueMessage
+	Message      string
+	ReferenceURL string
+	TestCases    []fixtureTestCase
+}
+
+type providerMeta struct {
+	RuleNameCCList []string
+}
+
+type ruleDocMeta struct {
+	RuleName      string
+	ResourceType  string
+	AttributeName string
+	BlockType     string
+	Description   string
+	Severity      string
+}
+
+type ruleDocIndexMeta struct {
+	RuleNameList []string
+}
+
+var BasePath string
+var RulesPath string
+var DocsPath string
+var SpecsPath string
+var schemaConstraints map[string]map[string]int // resource.attribute -> max_length
+var schemaEnums map[string]map[string][]string  // resource.attribute -> valid enum values
+
+func getFullPath(path string) string {
+	return fmt.Sprintf("%s/%s", BasePath, path)
+}
+
+var terraformSchema provider
+var generatedRuleNames []string = []string{}
+var generatedRuleNameCCs []string = []string{}
+
+// generateRules generates the rules, tests, docs and catalog from the mapping files, writing the outputs selected by
+// the command, and returns whether it failed: a spec file could not be read or the check command found stale files
+func generateRules() bool {
+	terraformSchema = loadProviderSchema()
+
+	// Load constraints from schema.json
+	schemaConstraints = extractSchemaConstraints(terraformSchema)
+
+	// Load enum values from schema.json
+	schemaEnums = extractSchemaEnums(terraformSchema)
+
+	// Build a set of known Terraform resources
+	knownResources := make(map[string]bool)
+	for resourceType := range terraformSchema.ResourceSchemas {
+		knownResources[resourceType] = true
+	}
+
+	files, err := filepath.Glob(getFullPath("mappings/*.hcl"))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(files) == 0 {
+		fmt.Println("Warning: No mapping files found in", getFullPath("mappings/"))
+		fmt.Println("Please create mapping files to define resource-to-spec relationships")
+		return false
+	}
+
+	// Mappings of resources the provider doesn't have yet are kept as pending
+	pending := pendingMappings(files, knownResources)
+	unmapped := unmappedResources(files, knownResources)
+
+	pendingSet := make(map[string]bool)
+	for _, mapping := range pending {
+		pendingSet[mapping] = true
+	}
+
+	if len(pending) > 0 {
+		fmt.Println("\n⏳ Pending mappings, their resources are not in schema.json yet:")
+		for _, mapping := range pending {
+			fmt.Printf("  - %s\n", mapping)
+		}
+		fmt.Println("\n   Rules are generated once a refreshed schema.json has the resource.")
+		fmt.Println("   If the provider has it under another name, rename the mapping file.")
+		fmt.Println()
+	}
+
+	shared, err := loadSharedConstraints()
+	if err != nil {
+		panic(err)
+	}
+
+	var unknownShared []string
+	mappingFiles := make([]mappingFile, 0, len(files))
+	for _, file := range files {
+		baseName := filepath.Base(file)
+		resourceName := strings.TrimSuffix(baseName, ".hcl")
+
+		parser := hclparse.NewParser()
+		f, diags := parser.ParseHCLFile(file)
+		if diags.HasErrors() {
+			panic(diags)
+		}
+
+		var mf mappingFile
+		diags = gohcl.DecodeBody(f.Body, nil, &mf)
+		if diags.HasErrors() {
+			panic(diags)
+		}
+		// Pending mappings are parsed too, so they still decode when their resource appears
+		if pendingSet[resourceName] {
+			continue
+		}
+		comments := manualComments(f)
+		for i := range mf.Mappings {
+			mf.Mappings[i].file = path.Join("mappings", baseName)
+			mf.Mappings[i].manualComments = comments[mf.Mappings[i].Resource]
+			unknownShared = append(unknownShared, applySharedConstraints(&mf.Mappings[i], shared)...)
+		}
+		mappingFiles = append(mappingFiles, mf)
+	}
+
+	if len(unknownShared) > 0 {
+		fmt.Printf("\n❌ %d attribute mappings use a shared block missing from mappings/shared/, nothing was generated:\n", len(unknownShared))
+		for _, attr := range unknownShared {
+			fmt.Printf("  - %s\n", attr)
+		}
+		return true
+	}
+
+	// The rules and the provenance in the catalog come from the specs, so without them nothing is generated rather
+	// than a registry missing most rules and a catalog without spec hashes
+	if missing := missingSpecFiles(mappingFiles); len(missing) > 0 {
+		fmt.Printf("\n❌ %d spec files could not be read from %s, nothing was generated:\n", len(missing), SpecsPath)
+		for _, file := range missing {
+			fmt.Printf("  - %s\n", file)
+		}
+		fmt.Println("\n   Set -specs-path, or specs_path in " + configFileName + ", to a fabric-rest-api-specs checkout.")
+		return true
+	}
+
+	// The previous catalog is compared with this run's to report changed constraints
+	previousCatalog := readCatalog()
+
+	var mappings []mapping
+	for _, mappingFile := range mappingFiles {
+		for _, mapping := range mappingFile.Mappings {
+			mappings = append(mappings, mapping)
+			mapping.DataSources = knownDataSources(mapping)
+
+			// Cross-attribute constraint rules only need the mapping and schema.json
+			processConstraints(mapping)
+
+			// Every spec file was read by missingSpecFiles
+			specFile := path.Clean(mapping.ImportPath)
+			spec, _ := specs.document(specFile)
+
+			// Support both Swagger 2.0 and OpenAPI 3.0
+			pointers := make(map[string]string)
+			if defs, ok := spec["definitions"].(map[string]interface{}); ok {
+				for k := range defs {
+					pointers[k] = "/definitions/" + jsonPointerEscape(k)
+				}
+			}
+			if comps, ok := spec["components"].(map[string]interface{}); ok {
+				if schemas, ok := comps["schemas"].(map[string]interface{}); ok {
+					for k := range schemas {
+						pointers[k] = "/components/schemas/" + jsonPointerEscape(k)
+					}
+				}
+			}
+
+			apiSpec := apiSpec{file: specFile, pointers: pointers}
+
+			// Process each attribute mapping
+			for _, attr := range mapping.Attributes {
+				processAttributeMapping(apiSpec, mapping, attr)
+			}
+
+			// Polymorphic API objects get a required-field rule per variant
+			for _, v := range mapping.Variants {
+				processVariants(apiSpec, mapping, v)
+			}
+		}
+	}
+
+	sort.Strings(generatedRuleNameCCs)
+	generateProviderFile(generatedRuleNameCCs)
+	sort.Strings(generatedRuleNames)
+	generateRulesIndexDoc(generatedRuleNames)
+	writeCatalog()
+
+	fmt.Printf("\n✅ Generated %d rules\n", len(generatedRuleNames))
+	fmt.Printf("Rules: %s/apispec/\n", RulesPath)
+	fmt.Printf("Docs: %s/rules/\n", DocsPath)
+
+	if len(pending) > 0 {
+		fmt.Printf("\n⏳ %d pending mapping files (see above)\n", len(pending))
+	}
+	if len(unmapped) > 0 {
+		fmt.Printf("\n📭 %d provider resources have no mapping file:\n", len(unmapped))
+		for _, resource := range unmapped {
+			fmt.Printf("  - %s\n", resource)
+		}
+	}
+
+	created, updated, skipped := 0, 0, 0
+	for _, c := range changeLog {
+		switch c.Status {
+		case "created":
+			created++
+		case "updated":
+			updated++
+		case "skipped":
+			skipped++
+		}
+	}
+
+	if CheckOnly {
+		fmt.Printf("\n=== Rule generation summary (check only, nothing written) ===\n")
+	} else {
+		fmt.Printf("\n=== Rule generation summary ===\n")
+	}
+	fmt.Printf("Created: %d  Updated: %d  Unchanged/Skipped: %d\n", created, updated, skipped)
+
+	if updated > 0 {
+		fmt.Println("\n-- Updated files (diffs) --")
+		for _, c := range changeLog {
+			if c.Status == "updated" {
+				fmt.Printf("\n[%s]\n%s\n", c.Path, c.Diff)
+			}
+		}
+	}
+	if created > 0 {
+		fmt.Println("\n-- Created files --")
+		for _, c := range changeLog {
+			if c.Status == "created" {
+				fmt.Printf("  %s\n", c.Path)
+			}
+		}
+	}
+	if skipped > 0 {
+		fmt.Println("\n-- Unchanged (skipped write) --")
+		for _, c := range changeLog {
+			if c.Status == "skipped" {
+				fmt.Printf("  %s\n", c.Path)
+			}
+		}
+	}
+	fmt.Println("================================")
+
+	if CheckOnly {
+		changes := catalogChanges(previousCatalog, catalogEntries)
+		return reportDrift(changes, unmappedSchemaAttributes(mappings), pending, unmapped)
+	}
+	return false
+}
+
+// missingSpecFiles returns the import_path of every mapping whose spec file can't be read, with the reason
+func missingSpecFiles(files []mappingFile) []string {
+	var missing []string
+	for _, mf := range files {
+		for _, m := range mf.Mappings {
+			if _, err := specs.document(path.Clean(m.ImportPath)); err != nil {
+				missing = append(missing, fmt.Sprintf("%s (%s): %v", m.ImportPath, m.Resource, err))
+			}
+		}
+	}
+	return missing
+}
+
+// pendingMappings finds mapping files whose resources are not in schema.json yet, e.g. items the API has before the
+// provider supports them
+func pendingMappings(files []string, knownResources map[string]bool) []string {
+	var pending []string
+
+	for _, file := range files {
+		// Extract resource name from filename (e.g., /path/to/fabric_workspace.hcl -> fabric_workspace)
+		baseName := filepath.Base(file)
+		resourceName := strings.TrimSuffix(baseName, ".hcl")
+
+		// Check if this resource exists in schema
+		if !knownResources[resourceName] {
+			pending = append(pending, resourceName)
+		}
+	}
+
+	sort.Strings(pending)
+	return pending
+}
+
+// unmappedResources finds the resources of schema.json that have no mapping file
+func unmappedResources(files []string, knownResources map[string]bool) []string {
+	mapped := make(map[string]bool, len(files))
+	for _, file := range files {
+		mapped[strings.TrimSuffix(filepath.Base(file), ".hcl")] = true
+	}
+
+	var unmapped []string
+	for resourceName := range knownResources {
+		if !mapped[resourceName] {
+			unmapped = append(unmapped, resourceName)
+		}
+	}
+
+	sort.Strings(unmapped)
+	return unmapped
+}
+
+// inferRequestObject tries to infer the request object name from the resource name
+// For example: fabric_activator -> CreateActivatorRequest, CreateReflex*, etc.
+func inferRequestObject(resourceName string, propertyName string, apiSpec apiSpec) string {
+	// Remove fabric_ prefix
+	shortName := strings.TrimPrefix(resourceName, "fabric_")
+
+	// Try common patterns
+	patterns := []string{
+		"Create" + toPascalCase(shortName) + "Request",
+		"Create" + toPascalCase(shortName),
+		toPascalCase(shortName) + "Request",
+		toPascalCase(shortName),
+	}
+
+	// Also check if there's a "Reflex" variant (like activator -> Reflex)
+	if shortName == "activator" {
+		patterns = append(patterns, "CreateReflexRequest", "CreateReflex", "ReflexRequest", "Reflex")
+	}
+
+	// Try each pattern
+	for _, pattern := range patterns {
+		pointer, ok := apiSpec.pointers[pattern]
+		if !ok {
+			continue
+		}
+		loc := specLocation{file: apiSpec.file, pointer: pointer}
+		if node, ok := specs.lookup(loc); ok {
+			// Check if the property exists, including properties inherited through allOf
+			if _, exists := ownProperty(specs.flatten(loc, node), propertyName); exists {
+				return pattern + "." + propertyName
+			}
+		}
+	}
+
+	return ""
+}
+
+// toPascalCase converts snake_case to PascalCase
+func toPascalCase(s string) string {
+	words := strings.Split(s, "_")
+	for i, word := range words {
+		if len(word) > 0 {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// toSnakeCase converts an API name like "KQLDatabase" or a value like "ServicePrincipal" to snake_case
+func toSnakeCase(s string) string {
+	// Handle common acronyms first
+	acronyms := map[string]string{
+		"SQL":     "sql",
+		"KQL":     "kql",
+		"ML":      "ml",
+		"API":     "api",
+		"ID":      "id",
+		"URL":     "url",
+		"URI":     "uri",
+		"HTTP":    "http",
+		"HTTPS":   "https",
+		"GraphQL": "graphql",
+	}
+
+	// Check if the entire string is an acronym
+	if replacement, exists := acronyms[s]; exists {
+		return replacement
+	}
+
+	// Replace known acronyms within the string
+	result := s
+	for acronym, replacement := range acronyms {
+		result = strings.ReplaceAll(result, acronym, strings.Title(replacement))
+	}
+
+	// Now convert camelCase/PascalCase to snake_case
+	var snake []rune
+	runes := []rune(result)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		// Add underscore before uppercase letter if:
+		// 1. Not the first character
+		// 2. Previous character is lowercase OR next character is lowercase (handles acronyms)
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			prevIsLower := runes[i-1] >= 'a' && runes[i-1] <= 'z'
+			nextIsLower := i+1 < len(runes) && runes[i+1] >= 'a' && runes[i+1] <= 'z'
+
+			if prevIsLower || nextIsLower {
+				snake = append(snake, '_')
+			}
+		}
+
+		snake = append(snake, r)
+	}
+
+	return strings.ToLower(string(snake))
+}
+
+func processAttributeMapping(apiSpec apiSpec, mapping mapping, attr attributeMapping) {
+	fmt.Printf("Generating rule for `%s.%s`\n", mapping.Resource, attr.Name)
+
+	// Support dot-notation "block.attr" and deeper paths like "target.onelake.path" in mapping attribute name
+	names := strings.Split(attr.Name, ".")
+	blockNames, attrName := names[:len(names)-1], names[len(names)-1]
+
+	schema, ok := lookupApiRef(apiSpec, mapping, attr.Name, attr.ApiRef)
+	if !ok {
+		return
+	}
+	definition, loc := schema.keywords, schema.loc
+
+	// Create manual constraints from attribute mapping
+	manualConstraints := &manualConstraint{
+		MaxLength:    attr.MaxLength,
+		MinLength:    attr.MinLength,
+		Pattern:      attr.Pattern,
+		WarnOnExceed: attr.WarnOnExceed,
+		ValidValues:  attr.ValidValues,
+		KeyPattern:   attr.KeyPattern,
+		TypeHint:     attr.TypeHint,
+		ReadOnly:     attr.ReadOnly,
+
+		PatternMessage:    attr.PatternMessage,
+		AllowedCharacters: attr.AllowedCharacters,
+		ReservedWords:     attr.ReservedWords,
+
+		Severity: attr.Severity,
+		Enabled:  attr.Enabled,
+		Message:  attr.Message,
+		Link:     attr.Link,
+
+		ManualDoc: attr.ManualDoc,
+	}
+	if attr.Severity != nil && ruleSeverities[*attr.Severity] == "" {
+		fmt.Printf("  Skipping %s.%s: severity %q must be error, warning or notice\n", mapping.Resource, attr.Name, *attr.Severity)
+		return
+	}
+
+	// Check if we have valid constraints
+	if !validMapping(definition, manualConstraints) {
+		fmt.Printf("  Skipping %s.%s - no valid constraints (UUID-only or no constraints)\n", mapping.Resource, attr.Name)
+		return
+	}
+
+	// Data sources listing the attribute get the same rule for their data blocks
+	refs := []attributeRef{{resource: mapping.Resource, blocks: blockNames, attribute: attrName}}
+	for _, dataSource := range mapping.DataSources {
+		for _, name := range dataSource.Attributes {
+			if name == attr.Name {
+				refs = append(refs, attributeRef{resource: dataSource.Name, dataSource: true, blocks: blockNames, attribute: attrName})
+			}
+		}
+	}
+
+	for _, ref := range refs {
+		attrSchema := extractAttrSchema(ref, definition, manualConstraints)
+
+		// Skip if attribute not found in Terraform provider
+		if attrSchema.Type == nil {
+			continue
+		}
+
+		meta := generateRuleFile(mapping, ref, definition, attrSchema, manualConstraints, loc)
+		generatedRuleNames = append(generatedRuleNames, meta.RuleName)
+		generatedRuleNameCCs = append(generatedRuleNameCCs, meta.RuleNameCC)
+	}
+}
+
+// lookupApiRef returns the schema an api_ref like "CreateConnectionRequest.credentialDetails.connectionEncryption"
+// points to, warning about name of mapping when it does not resolve
+func lookupApiRef(apiSpec apiSpec, mapping mapping, name, apiRef string) (specSchema, bool) {
+	// Parse the API reference (e.g., "CreateLakehouseRequest.displayName")
+	parts := strings.Split(apiRef, ".")
+
+	// If only property name is provided, try to infer the request object
+	if len(parts) == 1 {
+		inferredApiRef := inferRequestObject(mapping.Resource, apiRef, apiSpec)
+		if inferredApiRef == "" {
+			unresolvedRef(mapping.Resource, name, "Invalid API reference '%s' (cannot infer request object)", apiRef)
+			return specSchema{}, false
+		}
+		fmt.Printf("  Inferred API reference: %s\n", inferredApiRef)
+		parts = strings.Split(inferredApiRef, ".")
+	}
+
+	if len(parts) < 2 {
+		unresolvedRef(mapping.Resource, name, "Invalid API reference '%s'", apiRef)
+		return specSchema{}, false
+	}
+
+	// Get the definition from the API spec
+	pointer, ok := apiSpec.pointers[parts[0]]
+	if !ok {
+		unresolvedRef(mapping.Resource, name, "Definition '%s' not found in API spec", parts[0])
+		return specSchema{}, false
+	}
+	loc := specLocation{file: apiSpec.file, pointer: pointer}
+	node, ok := specs.lookup(loc)
+	if !ok {
+		unresolvedRef(mapping.Resource, name, "Definition '%s' is not a map", parts[0])
+		return specSchema{}, false
+	}
+
+	// Walk the property path through $refs, allOf members and polymorphic variants
+	schema := specs.flatten(loc, node)
+	for i, property := range parts[1:] {
+		owner := strings.Join(parts[:i+1], ".")
+		// Lists of objects are walked into like objects, e.g. "DomainRoleAssignmentRequest.principals.type"
+		if items, ok := specs.arrayItems(schema); ok {
+			schema = items
+		}
+		if !specs.hasProperties(schema) {
+			unresolvedRef(mapping.Resource, name, "Definition '%s' has no properties", owner)
+			return specSchema{}, false
+		}
+		schema, ok = specs.property(schema, property)
+		if !ok {
+			unresolvedRef(mapping.Resource, name, "Property '%s' not found in '%s'", property, owner)
+			return specSchema{}, false
+		}
+	}
+	return schema, true
+}
+
+// knownDataSources returns the data sources of m that exist in schema.json and warns about the others
+func knownDataSources(m mapping) []dataSourceMapping {
+	mapped := make(map[string]bool, len(m.Attributes))
+	for _, attr := range m.Attributes {
+		mapped[attr.Name] = true
+	}
+
+	var known []dataSourceMapping
+	for _, dataSource := range m.DataSources {
+		if _, ok := terraformSchema.DataSourceSchemas[dataSource.Name]; !ok {
+			fmt.Printf("⚠️  Warning: data source `%s` in %s mapping not found in schema.json, skipping\n", dataSource.Name, m.Resource)
+			continue
+		}
+		for _, name := range dataSource.Attributes {
+			if !mapped[name] {
+				fmt.Printf("⚠️  Warning: data source `%s` lists `%s`, which has no attribute mapping in %s\n", dataSource.Name, name, m.Resource)
+			}
+		}
+		known = append(known, dataSource)
+	}
+	return known
+}
+
+func validMapping(definition map[string]interface{}, manualConstraints *manualConstraint) bool {
+	// Check if we have manual constraints
+	if manualConstraints != nil {
+		if manualConstraints.MaxLength != nil || manualConstraints.MinLength != nil ||
+			manualConstraints.Pattern != nil || len(manualConstraints.ValidValues) > 0 ||
+			manualConstraints.KeyPattern != nil || manualConstraints.TypeHint != nil ||
+			len(manualConstraints.ReservedWords) > 0 {
+			return true
+		}
+		if manualConstraints.ReadOnly != nil && *manualConstraints.ReadOnly {
+			return false
+		}
+	}
+
+	// Otherwise check API spec definition
+	defType, ok := definition["type"].(string)
+	if !ok {
+		return false
+	}
+
+	switch defType {
+	case "string":
+		// Skip readOnly fields - these are output-only and can't be validated
+		if readOnly, ok := definition["readOnly"].(bool); ok && readOnly {
+			return false
+		}
+
+		if _, ok := definition["enum"]; ok {
+			return true
+		}
+		if _, ok := definition["pattern"]; ok {
+			return true
+		}
+		if _, ok := definition["maxLength"]; ok {
+			return true
+		}
+		if _, ok := definition["minLength"]; ok {
+			return true
+		}
+		if _, ok := definition["format"]; ok {
+			format, _ := definition["format"].(string)
+			// Skip UUID validation - these are almost always Terraform references
+			// Valid formats that we can validate
+			if format == "date-time" || format == "uuid" || format == "uri" {
+				return true
+			}
+		}
+		return false
+	case "integer", "number":
+		if _, ok := definition["maximum"]; ok {
+			return true
+		}
+		if _, ok := definition["minimum"]; ok {
+			return true
+		}
+		return false
+	case "boolean":
+		// allow boolean when manual pattern/enum provided
+		return true
+	default:
+		return false
+	}
+}
+
+func extractAttrSchema(ref attributeRef, definition map[string]interface{}, manualConstraints *manualConstraint) attribute {
+	if _, ok := terraformSchema.schemaOf(ref.blockType(), ref.resource); !ok {
+		// Resource not found in Terraform provider - return empty to skip
+		fmt.Printf("⚠️  Warning: %s `%s` exists in API spec but not yet supported in Terraform provider\n", ref.blockType(), ref.resource)
+		return attribute{}
+	}
+	// Nested paths may go through blocks and nested attributes, see schemaAttribute
+	attrSchema, ok := schemaAttribute(ref.blockType(), ref.resource, ref.path())
+	if !ok {
+		// Return a warning instead of panic - attribute exists in API spec but not in Terraform provider yet
+		fmt.Printf("⚠️  Warning: `%s` exists in API spec but not yet supported in Terraform provider\n", ref.String())
+		return attribute{} // Return empty attribute to skip rule generation
+	}
+	if ref.dataSource && !attrSchema.Required && !attrSchema.Optional {
+		// Computed-only data source attributes are never written in configurations
+		fmt.Printf("⚠️  Warning: `%s` is not a data source argument, skipping\n", ref.String())
+		return attribute{}
+	}
+
+	// Check if definition has a type field
+	defTypeRaw, hasType := definition["type"]
+
+	// If we have manual ValidValues and no type in definition, skip type validation
+	// This happens with enum references that don't have inline type definitions
+	if (!hasType || defTypeRaw == nil) && manualConstraints != nil && len(manualConstraints.ValidValues) > 0 {
+		// Just return the Terraform schema without validating against API definition type
+		return attrSchema
+	}
+
+	if !hasType || defTypeRaw == nil {
+		// allow manual constraints (type_hint, pattern, etc.)
+		if manualConstraints != nil && (manualConstraints.Pattern != nil || len(manualConstraints.ValidValues) > 0 || manualConstraints.TypeHint != nil) {
+			return attrSchema
+		}
+		fmt.Printf("⚠️  Warning: `%s` has no type in API definition, skipping\n", ref.String())
+		return attribute{}
+	}
+
+	defType, ok := defTypeRaw.(string)
+	if !ok {
+		fmt.Printf("⚠️  Warning: `%s` has non-string type in API definition: %T, skipping\n", ref.String(), defTypeRaw)
+		return attribute{}
+	}
+	ty, ok := attrSchema.Type.(string)
+	if !ok {
+		panic(fmt.Sprintf("`%s` type error in schema", ref.String()))
+	}
+
+	switch defType {
+	case "string":
+		if ty != "string" && ty != "number" {
+			fmt.Printf("⚠️  Type mismatch for `%s` (spec string vs provider %s) — continuing due to manual/type_hint support\n", ref.String(), attrSchema.Type)
+		}
+	case "integer", "number":
+		if ty != "number" && ty != "string" {
+			fmt.Printf("⚠️  Type mismatch for `%s` (spec number vs provider %s) — continuing due to manual/type_hint support\n", ref.String(), attrSchema.Type)
+		}
+	case "boolean":
+		// allow boolean with string/number schema (rare), just warn
+		if ty != "bool" && ty != "string" && ty != "number" {
+			fmt.Printf("⚠️  Type mismatch for `%s` (spec boolean vs provider %s)\n", ref.String(), attrSchema.Type)
+		}
+	}
+
+	return attrSchema
+}
+
+func generateRuleFile(mapping mapping, ref attributeRef, definition map[string]interface{}, schema attribute, manualConstraints *manualConstraint, loc specLocation) *ruleMeta {
+	ruleName := ref.RuleName()
+
+	meta := &ruleMeta{
+		RuleName:      ruleName,
+		RuleNameCC:    toCamelCase(ruleName),
+		BlockType:     strings.Join(ref.blocks, "."),
+		BlockPath:     ref.blocks,
+		BlockKind:     ref.blockType(),
+		ResourceType:  ref.resource,
+		AttributeName: ref.attribute,
+		AttributePath: ref.path(),
+		Sensitive:     schema.Sensitive,
+		Max:           fetchNumber(definition, "maximum"),
+		SetMax:        numberExists(definition, "maximum"),
+		Min:           fetchNumber(definition, "minimum"),
+		SetMin:        numberExists(definition, "minimum"),
+		Pattern:       fetchString(definition, "pattern"),
+		Enum:          fetchStrings(definition, "enum"),
+		Format:        fetchString(definition, "format"),
+		ReadOnly:      fetchBool(definition, "readOnly"),
+		Severity:      "ERROR",
+		Enabled:       true,
+		ReferenceURL:  fmt.Sprintf("https://github.com/microsoft/fabric-rest-api-specs/tree/main/%s", strings.TrimPrefix(mapping.ImportPath, "./")),
+	}
+
+	// Apply manual type hints
+	if manualConstraints != nil && manualConstraints.TypeHint != nil {
+		meta.Format = *manualConstraints.TypeHint
+	}
+	// Support map key pattern (if provided, your rule template can read this via Pattern)
+	if manualConstraints != nil && manualConstraints.KeyPattern != nil && meta.Pattern == "" {
+		meta.Pattern = *manualConstraints.KeyPattern
+	}
+
+	// Priority: manual constraints > schema.json > API spec
+	// Get schema.json constraint if available
+	var schemaMaxLength *int
+	if resourceConstraints, ok := schemaConstraints[mapping.Resource]; ok {
+		if maxLen, found := resourceConstraints[ref.path()]; found {
+			schemaMaxLength = &maxLen
+		}
+	}
+
+	// Merge constraints with priority: manual > schema.json > API spec
+	if manualConstraints != nil {
+		if manualConstraints.MaxLength != nil {
+			meta.MaxLength = *manualConstraints.MaxLength
+			meta.SetMaxLength = true
+		} else if schemaMaxLength != nil {
+			meta.MaxLength = *schemaMaxLength
+			meta.SetMaxLength = true
+		} else {
+			meta.MaxLength = fetchNumber(definition, "maxLength")
+			meta.SetMaxLength = numberExists(definition, "maxLength")
+		}
+
+		if manualConstraints.MinLength != nil {
+			meta.MinLength = *manualConstraints.MinLength
+			meta.SetMinLength = true
+		} else {
+			meta.MinLength = fetchNumber(definition, "minLength")
+			meta.SetMinLength = numberExists(definition, "minLength")
+		}
+
+		if manualConstraints.Pattern != nil {
+			meta.Pattern = *manualConstraints.Pattern
+		}
+
+		if manualConstraints.PatternMessage != nil {
+			meta.PatternMessage = *manualConstraints.PatternMessage
+		}
+
+		if manualConstraints.AllowedCharacters != nil {
+			meta.AllowedCharacters = *manualConstraints.AllowedCharacters
+		}
+
+		meta.ReservedWords = manualConstraints.ReservedWords
+
+		if manualConstraints.WarnOnExceed != nil {
+			meta.WarnOnExceed = *manualConstraints.WarnOnExceed
+		}
+
+		// warn_on_exceed is short for severity = "warning"
+		if manualConstraints.Severity != nil {
+			meta.Severity = ruleSeverities[*manualConstraints.Severity]
+		} else if meta.WarnOnExceed {
+			meta.Severity = "WARNING"
+		}
+		if manualConstraints.Enabled != nil {
+			meta.Enabled = *manualConstraints.Enabled
+		}
+		if manualConstraints.Message != nil {
+			meta.Message = *manualConstraints.Message
+		}
+		if manualConstraints.Link != nil {
+			meta.ReferenceURL = *manualConstraints.Link
+		}
+
+		// valid_values replace the enum of the API spec
+		if len(manualConstraints.ValidValues) > 0 {
+			if len(meta.Enum) > 0 && !ref.dataSource {
+				compareEnums(ref, meta.Enum, manualConstraints.ValidValues)
+			}
+			meta.Enum = manualConstraints.ValidValues
+		}
+	} else {
+		// No manual constraints, check schema.json then API spec
+		if schemaMaxLength != nil {
+			meta.MaxLength = *schemaMaxLength
+			meta.SetMaxLength = true
+		} else {
+			meta.MaxLength = fetchNumber(definition, "maxLength")
+			meta.SetMaxLength = numberExists(definition, "maxLength")
+		}
+		meta.MinLength = fetchNumber(definition, "minLength")
+		meta.SetMinLength = numberExists(definition, "minLength")
+	}
+
+	// Filter the enum to only include values supported by Terraform
+	// Check if schema.json has enum constraints for this attribute
+	if terraformEnums, ok := schemaEnums[mapping.Resource][ref.path()]; ok && len(meta.Enum) > 0 {
+		var filteredEnums []string
+		for _, val := range meta.Enum {
+			if contains(terraformEnums, val) {
+				filteredEnums = append(filteredEnums, val)
+			}
+		}
+
+		if len(filteredEnums) > 0 {
+			// Log if we filtered out values
+			if len(filteredEnums) < len(meta.Enum) {
+				fmt.Printf("  ℹ️  Filtered %s.%s enum from %d to %d values (Terraform-supported only)\n",
+					ref.resource, ref.attribute, len(meta.Enum), len(filteredEnums))
+			}
+			meta.Enum = filteredEnums
+		}
+	}
+
+	if meta.Pattern != "" {
+		regexp.MustCompile(meta.Pattern)
+		if meta.AllowedCharacters == "" {
+			meta.AllowedCharacters = allowedCharactersFromPattern(meta.Pattern)
+		}
+	}
+	if meta.AllowedCharacters != "" {
+		regexp.MustCompile("^[" + meta.AllowedCharacters + "]$")
+	}
+
+	if len(ref.blocks) > 0 {
+		generateFile(fmt.Sprintf("%s/apispec/nested.go", RulesPath), getFullPath("nested.go.tmpl"), nil)
+	}
+	if meta.Message != "" {
+		generateFile(fmt.Sprintf("%s/apispec/message.go", RulesPath), getFullPath("message.go.tmpl"), nil)
+	}
+	meta.TestCases = meta.testCases()
+
+	generateFile(fmt.Sprintf("%s/apispec/%s.go", RulesPath, ruleName), getFullPath("rule.go.tmpl"), meta)
+	generateFile(fmt.Sprintf("%s/apispec/%s_test.go", RulesPath, ruleName), getFullPath("rule_test.go.tmpl"), meta)
+	if manualConstraints.ManualDoc != nil && *manualConstraints.ManualDoc {
+		fmt.Printf("  Keeping the hand-written %s/rules/%s.md\n", DocsPath, ruleName)
+	} else {
+		generateFile(fmt.Sprintf("%s/rules/%s.md", DocsPath, ruleName), getFullPath("rule.md.tmpl"), meta)
+	}
+	catalogAttributeRule(mapping, ref, meta, definition, manualConstraints, loc)
+
+	return meta
+}
+
+// singleClassPattern matches patterns made of one repeated character class, e.g. ^[a-zA-Z0-9_]+$
+var singleClassPattern = regexp.MustCompile(`^\^\[(.+)\][+*]\$$`)
+
+// allowedCharactersFromPattern derives the allowed character class from simple patterns,
+// so generated rules can list offending characters without a manual allowed_characters
+func allowedCharactersFromPattern(pattern string) string {
+	matches := singleClassPattern.FindStringSubmatch(pattern)
+	if matches == nil {
+		return ""
+	}
+	if _, err := regexp.Compile("^[" + matches[1] + "]$"); err != nil {
+		return ""
+	}
+	return matches[1]
+}
+
+func generateProviderFile(ruleNames []string) {
+	meta := &providerMeta{RuleNameCCList: ruleNames}
+	generateFile(fmt.Sprintf("%s/apispec/provider.go", RulesPath), getFullPath("provider.go.tmpl"), meta)
+}
+
+func generateRulesIndexDoc(ruleNames []string) {
+	meta := &ruleDocIndexMeta{RuleNameList: ruleNames}
+	generateFile(fmt.Sprintf("%s/README.md", DocsPath), getFullPath("doc_README.md.tmpl"), meta)
+}
+
+func fetchNumber(definition map[string]interface{}, key string) int {
+	if v, ok := definition[key]; ok {
+		switch t := v.(type) {
+		case float64:
+			return int(t)
+		case int:
+			return t
+		}
+	}
+	return 0
+}
+
+func numberExists(definition map[string]interface{}, key string) bool {
+	_, ok := definition[key]
+	return ok
+}
+
+func fetchString(definition map[string]interface{}, key string) string {
+	if v, ok := definition[key]; ok {
+		if s, ok := v.(string); ok {
+			return s
+		}
+	}
+	return ""
+}
+
+func fetchBool(definition map[string]interface{}, key string) bool {
+	if v, ok := definition[key]; ok {
+		if b, ok := v.(bool); ok {
+			return b
+		}
+	}
+	return false
+}
+
+func fetchStrings(definition map[string]interface{}, key string) []string {
+	if raw, ok := definition[key]; ok {
+		if list, ok := raw.([]interface{}); ok {
+			ret := make([]string, 0, len(list))
+			for _, v := range list {
+				if s, ok := v.(string); ok {
+					ret = append(ret, s)
+				}
+			}
+			return ret
+		}
+	}
+	return nil
+}
+
+func generateFile(fileName string, tmplPath string, meta any) {
+	// parse template with helper funcs (needed by the constraint templates)
+	tmpl := template.Must(
+		template.New(path.Base(tmplPath)).
+			Funcs(template.FuncMap{
+				"split": strings.Split,
+				"join":  strings.Join,
+				"lower": strings.ToLower,
+			}).
+			ParseFiles(tmplPath),
+	)
+
+	// render to memory
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, meta); err != nil {
+		panic(err)
+	}
+	newContent := buf.Bytes()
+
+	// Format Go source files before comparing/writing
+	if strings.HasSuffix(fileName, ".go") {
+		if formatted, err := format.Source(newContent); err == nil {
+			newContent = formatted
+		}
+	}
+
+	writeFile(fileName, newContent)
+}
+
+// writeFile writes newContent to fileName unless it is unchanged or not an output of the command, and records the change
+func writeFile(fileName string, newContent []byte) {
+	if !outputs[outputKind(fileName)] {
+		return
+	}
+
+	// read old file if exists
+	oldContent, _ := os.ReadFile(fileName)
+	same := len(oldContent) > 0 && sha256.Sum256(oldContent) == sha256.Sum256(newContent)
+
+	if same {
+		changeLog = append(changeLog, ChangeRecord{Path: fileName, Status: "skipped"})
+		return
+	}
+
+	// With check the change is only recorded, so the summary shows what a generation run would do
+	if !CheckOnly {
+		if err := os.MkdirAll(filepath.Dir(fileName), 0o755); err != nil {
+			panic(err)
+		}
+		if err := os.WriteFile(fileName, newContent, 0o644); err != nil {
+			panic(err)
+		}
+	}
+
+	if len(oldContent) == 0 {
+		changeLog = append(changeLog, ChangeRecord{Path: fileName, Status: "created"})
+	} else {
+		changeLog = append(changeLog, ChangeRecord{
+			Path:   fileName,
+			Status: "updated",
+			Diff:   quickLineDiff(string(oldContent), string(newContent), 200),
+		})
+	}
+}
+
+func quickLineDiff(a, b string, max int) string {
+	as := strings.Split(a, "\n")
+	bs := strings.Split(b, "\n")
+	var out strings.Builder
+	i, j, shown := 0, 0, 0
+	for i < len(as) && j < len(bs) {
+		if as[i] == bs[j] {
+			i++
+			j++
+			continue
+		}
+		if shown >= max {
+			out.WriteString("... (diff truncated)\n")
+			break
+		}
+		out.WriteString("- " + as[i] + "\n")
+		out.WriteString("+ " + bs[j] + "\n")
+		i++
+		j++
+		shown++
+	}
+	for ; shown < max && i < len(as); i, shown = i+1, shown+1 {
+		out.WriteString("- " + as[i] + "\n")
+	}
+	for ; shown < max && j < len(bs); j, shown = j+1, shown+1 {
+		out.WriteString("+ " + bs[j] + "\n")
+	}
+	return out.String()
+}
+
+var heading = regexp.MustCompile("(^[A-Za-z])|_([A-Za-z])")
+
+func toCamelCase(str string) string {
+	exceptions := map[string]string{
+		"ip":        "IP",
+		"sql":       "SQL",
+		"vm":        "VM",
+		"os":        "OS",
+		"id":        "ID",
+		"tls":       "TLS",
+		"api":       "API",
+		"uuid":      "UUID",
+		"url":       "URL",
+		"kql":       "KQL",
+		"lakehouse": "Lakehouse",
+		"workspace": "Workspace",
+		"fabric":    "Fabric",
+	}
+	parts := strings.Split(str, "_")
+	replaced := make([]string, len(parts))
+	for i, s := range parts {
+		conv, ok := exceptions[s]
+		if ok {
+			replaced[i] = conv
+		} else {
+			replaced[i] = s
+		}
+	}
+	str = strings.Join(replaced, "_")
+
+	return heading.ReplaceAllStringFunc(str, func(s string) string {
+		return strings.ToUpper(strings.Replace(s, "_", "", -1))
+	})
+}
+
+// extractSchemaConstraints parses schema.json to find "String length must be at most X" patterns
+func extractSchemaConstraints(schema provider) map[string]map[string]int {
+	constraints := make(map[string]map[string]int)
+	lengthPattern := regexp.MustCompile(`String length must be at most (\d+)`)
+
+	for resourceType, resourceSchema := range schema.ResourceSchemas {
+		resourceConstraints := make(map[string]int)
+
+		// Nested attributes use dotted paths, e.g. "git_provider_details.repository_name"
+		walkSchemaAttributes(resourceSchema.Block, "", func(path string, attrSchema attribute) {
+			if matches := lengthPattern.FindStringSubmatch(attrSchema.Description); len(matches) > 1 {
+				maxLen := 0
+				fmt.Sscanf(matches[1], "%d", &maxLen)
+				resourceConstraints[path] = maxLen
+			}
+		})
+
+		if len(resourceConstraints) > 0 {
+			constraints[resourceType] = resourceConstraints
+		}
+	}
+
+	return constraints
+}
+
+// extractSchemaEnums parses schema.json to find "Value must be one of : X, Y, Z" patterns
+func extractSchemaEnums(schema provider) map[string]map[string][]string {
+	enums := make(map[string]map[string][]string)
+	// Pattern to match: "Value must be one of : `Val1`, `Val2`, `Val3`"
+	enumPattern := regexp.MustCompile(`Value must be one of\s*:\s*([^.]+)`)
+	valuePattern := regexp.MustCompile("`([^`]+)`")
+
+	for resourceType, resourceSchema := range schema.ResourceSchemas {
+		resourceEnums := make(map[string][]string)
+
+		walkSchemaAttributes(resourceSchema.Block, "", func(path string, attrSchema attribute) {
+			matches := enumPattern.FindStringSubmatch(attrSchema.Description)
+			if len(matches) < 2 {
+				return
+			}
+			// Expected format: "`Value1`, `Value2`, `Value3`"
+			var values []string
+			for _, vm := range valuePattern.FindAllStringSubmatch(matches[1], -1) {
+				values = append(values, vm[1])
+			}
+			if len(values) > 0 {
+				resourceEnums[path] = values
+			}
+		})
+
+		if len(resourceEnums) > 0 {
+			enums[resourceType] = resourceEnums
+		}
+	}
+
+	return enums
+}
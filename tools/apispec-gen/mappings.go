@@ -2,21 +2,24 @@ package main
 
 import (
 	"encoding/json"
-	"flag"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 )
 
-// Tool to analyze Fabric REST API specs and generate mapping files
-// This scans the fabric-rest-api-specs directory and extracts validation constraints
+// The mappings command analyzes the Fabric REST API specs and generates mapping files
+// It scans the fabric-rest-api-specs directory and extracts validation constraints
+
 // directoryPrefixes maps API spec directory names to resource name prefixes
 // Use this when resources in a directory should have a specific prefix
 var directoryPrefixes = map[string]string{
@@ -68,11 +71,55 @@ type SwaggerSpec struct {
 	Info        map[string]interface{}      `json:"info"`
 	Paths       map[string]interface{}      `json:"paths"`
 	Definitions map[string]DefinitionSchema `json:"definitions"`
+	// Components holds the schemas of OpenAPI 3 specs, merged into Definitions by loadSpec
+	Components struct {
+		Schemas map[string]DefinitionSchema `json:"schemas"`
+	} `json:"components"`
+}
+
+// loadSpec reads a Swagger 2.0 or OpenAPI 3 spec, relative to -specs-path, through the loader shared with rule
+// generation; OpenAPI 3 components/schemas become definitions
+func loadSpec(file string) (SwaggerSpec, error) {
+	var spec SwaggerSpec
+	doc, err := specs.document(file)
+	if err != nil {
+		return spec, err
+	}
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return spec, err
+	}
+	if err := json.Unmarshal(raw, &spec); err != nil {
+		return spec, err
+	}
+	if len(spec.Components.Schemas) > 0 && spec.Definitions == nil {
+		spec.Definitions = make(map[string]DefinitionSchema, len(spec.Components.Schemas))
+	}
+	for name, schema := range spec.Components.Schemas {
+		spec.Definitions[name] = schema
+	}
+	return spec, nil
+}
+
+// schemaType is the type of a schema, which OpenAPI 3.1 allows to be a list like ["string", "null"]
+type schemaType string
+
+func (t *schemaType) UnmarshalJSON(data []byte) error {
+	var types []string
+	if err := json.Unmarshal(data, &types); err != nil {
+		return json.Unmarshal(data, (*string)(t))
+	}
+	for _, name := range types {
+		if name != "null" {
+			*t = schemaType(name)
+		}
+	}
+	return nil
 }
 
 type DefinitionSchema struct {
 	Description string                    `json:"description"`
-	Type        string                    `json:"type"`
+	Type        schemaType                `json:"type"`
 	Required    []string                  `json:"required"`
 	Properties  map[string]PropertySchema `json:"properties"`
 	AllOf       []map[string]interface{}  `json:"allOf"`
@@ -86,15 +133,15 @@ type DefinitionSchema struct {
 }
 
 type PropertySchema struct {
-	Description string   `json:"description"`
-	Type        string   `json:"type"`
-	Format      string   `json:"format"`
-	MaxLength   int      `json:"maxLength"`
-	MinLength   int      `json:"minLength"`
-	Pattern     string   `json:"pattern"`
-	Enum        []string `json:"enum"`
-	ReadOnly    bool     `json:"readOnly"`
-	Ref         string   `json:"$ref"`
+	Description string     `json:"description"`
+	Type        schemaType `json:"type"`
+	Format      string     `json:"format"`
+	MaxLength   int        `json:"maxLength"`
+	MinLength   int        `json:"minLength"`
+	Pattern     string     `json:"pattern"`
+	Enum        []string   `json:"enum"`
+	ReadOnly    bool       `json:"readOnly"`
+	Ref         string     `json:"$ref"`
 }
 
 type ResourceInfo struct {
@@ -114,6 +161,23 @@ type PropertyConstraints struct {
 	Required       bool
 	SourceRequests []string // Tracks all Create*Request types this property came from
 	ApiRef         string   // For manual attributes, stores the original api_ref
+
+	// Manual-only constraints, preserved from the existing mapping file
+	PatternMessage    string
+	AllowedCharacters string
+	ReservedWords     []string
+	ManualComments    []string // "// MANUAL:" comment lines written above the attribute
+
+	// Rule settings, preserved from the existing mapping file
+	WarnOnExceed *bool
+	Severity     string
+	Enabled      *bool
+	Message      string
+	Link         string
+
+	// Shared and ManualDoc, preserved from the existing mapping file
+	Shared    string
+	ManualDoc *bool
 }
 
 // Structures for parsing existing HCL mapping files
@@ -125,6 +189,27 @@ type existingMapping struct {
 	Resource   string                     `hcl:"resource,label"`
 	ImportPath string                     `hcl:"import_path"`
 	Attributes []existingAttributeMapping `hcl:"attribute,block"`
+	// Constraints are hand-written and copied verbatim, see parseExistingConstraints
+	Constraints []existingConstraint `hcl:"constraint,block"`
+	// DataSources are hand-written like constraints and copied the same way
+	DataSources []existingDataSource `hcl:"data_source,block"`
+	// Variants are hand-written like constraints and copied the same way
+	Variants []existingVariants `hcl:"variants,block"`
+}
+
+type existingConstraint struct {
+	Name   string   `hcl:"name,label"`
+	Remain hcl.Body `hcl:",remain"`
+}
+
+type existingDataSource struct {
+	Name   string   `hcl:"name,label"`
+	Remain hcl.Body `hcl:",remain"`
+}
+
+type existingVariants struct {
+	Name   string   `hcl:"name,label"`
+	Remain hcl.Body `hcl:",remain"`
 }
 
 type existingAttributeMapping struct {
@@ -135,20 +220,31 @@ type existingAttributeMapping struct {
 	Pattern      *string  `hcl:"pattern,optional"`
 	WarnOnExceed *bool    `hcl:"warn_on_exceed,optional"`
 	ValidValues  []string `hcl:"valid_values,optional"`
-}
 
-func main() {
-	var specsPath, outputPath string
-	var skipExisting bool
-	flag.StringVar(&specsPath, "specs", "../fabric-rest-api-specs", "Path to fabric-rest-api-specs directory")
-	flag.StringVar(&outputPath, "output", "mappings", "Output directory for mapping files")
-	flag.BoolVar(&skipExisting, "skip-existing", false, "Skip files that already exist (default: false, will merge updates)")
-	flag.Parse()
+	PatternMessage    *string  `hcl:"pattern_message,optional"`
+	AllowedCharacters *string  `hcl:"allowed_characters,optional"`
+	ReservedWords     []string `hcl:"reserved_words,optional"`
+
+	Severity *string `hcl:"severity,optional"`
+	Enabled  *bool   `hcl:"enabled,optional"`
+	Message  *string `hcl:"message,optional"`
+	Link     *string `hcl:"link,optional"`
 
-	fmt.Println("Analyzing Fabric API specs from:", specsPath)
+	Shared    *string `hcl:"shared,optional"`
+	ManualDoc *bool   `hcl:"manual_doc,optional"`
+
+	// ManualComments is not part of the HCL body, it is read from the raw file
+	ManualComments []string
+}
+
+// generateMappings writes a mapping file for each Create*Request of the API specs and returns how many were, or with
+// check would be, created or updated
+func generateMappings(skipExisting bool) int {
+	outputPath := getFullPath("mappings")
+	fmt.Println("Analyzing Fabric API specs from:", SpecsPath)
 
 	// Scan all spec directories
-	entries, err := os.ReadDir(specsPath)
+	entries, err := os.ReadDir(SpecsPath)
 	if err != nil {
 		fmt.Printf("Error reading specs directory: %v\n", err)
 		os.Exit(1)
@@ -168,55 +264,26 @@ func main() {
 			continue
 		}
 
-		// Look for definition files in the main directory
+		// Look for definition files in the main directory, as paths relative to the specs directory
 		var definitionFiles []string
 
 		// Check for common naming patterns in main directory
 		possibleFiles := []string{"definitions.json", dirName + ".json", "swagger.json"}
 		for _, filename := range possibleFiles {
-			testPath := filepath.Join(specsPath, dirName, filename)
-			if _, err := os.Stat(testPath); err == nil {
-				// Found a definition file - check if it has Swagger definitions
-				data, err := os.ReadFile(testPath)
-				if err != nil {
-					continue
-				}
-
-				var spec SwaggerSpec
-				if err := json.Unmarshal(data, &spec); err != nil {
-					continue
-				}
-
-				// Only use if it has definitions
-				if len(spec.Definitions) > 0 {
-					definitionFiles = append(definitionFiles, testPath)
-				}
+			relPath := path.Join(dirName, filename)
+			// Only use if it has definitions
+			if spec, err := loadSpec(relPath); err == nil && len(spec.Definitions) > 0 {
+				definitionFiles = append(definitionFiles, relPath)
 			}
 		}
 
 		// Also check for a definitions/ subdirectory
-		defsSubdir := filepath.Join(specsPath, dirName, "definitions")
-		if stat, err := os.Stat(defsSubdir); err == nil && stat.IsDir() {
-			subFiles, err := os.ReadDir(defsSubdir)
-			if err == nil {
-				for _, subFile := range subFiles {
-					if !subFile.IsDir() && strings.HasSuffix(subFile.Name(), ".json") {
-						testPath := filepath.Join(defsSubdir, subFile.Name())
-						data, err := os.ReadFile(testPath)
-						if err != nil {
-							continue
-						}
-
-						var spec SwaggerSpec
-						if err := json.Unmarshal(data, &spec); err != nil {
-							continue
-						}
-
-						// Only use if it has definitions
-						if len(spec.Definitions) > 0 {
-							definitionFiles = append(definitionFiles, testPath)
-						}
-					}
+		subFiles, _ := os.ReadDir(filepath.Join(SpecsPath, dirName, "definitions"))
+		for _, subFile := range subFiles {
+			if !subFile.IsDir() && strings.HasSuffix(subFile.Name(), ".json") {
+				relPath := path.Join(dirName, "definitions", subFile.Name())
+				if spec, err := loadSpec(relPath); err == nil && len(spec.Definitions) > 0 {
+					definitionFiles = append(definitionFiles, relPath)
 				}
 			}
 		}
@@ -228,25 +295,18 @@ func main() {
 		fmt.Printf("\nAnalyzing %s (found %d definition files)...\n", dirName, len(definitionFiles))
 
 		// Analyze all definition files for this directory
-		for _, defPath := range definitionFiles {
-			data, err := os.ReadFile(defPath)
+		for _, relPath := range definitionFiles {
+			spec, err := loadSpec(relPath)
 			if err != nil {
-				fmt.Printf("  Error reading %s: %v\n", defPath, err)
-				continue
-			}
-
-			var spec SwaggerSpec
-			if err := json.Unmarshal(data, &spec); err != nil {
-				fmt.Printf("  Error parsing %s: %v\n", defPath, err)
+				fmt.Printf("  Error parsing %s: %v\n", relPath, err)
 				continue
 			}
 
 			// Find Create/Update request definitions - there may be multiple in one file
-			relPath, _ := filepath.Rel(specsPath, defPath)
 			resources := analyzeSpecForResources(dirName, relPath, spec)
 			for resourceName, info := range resources {
 				resourceMap[resourceName] = info
-				fmt.Printf("  ✓ Found %s with %d constraints in %s\n", resourceName, len(info.Constraints), filepath.Base(defPath))
+				fmt.Printf("  ✓ Found %s with %d constraints in %s\n", resourceName, len(info.Constraints), path.Base(relPath))
 			}
 		}
 	}
@@ -254,13 +314,33 @@ func main() {
 	fmt.Printf("\n✅ Analyzed %d resource types\n", len(resourceMap))
 
 	// Generate mapping files
-	if err := generateMappingFiles(resourceMap, outputPath, skipExisting); err != nil {
+	stale, err := generateMappingFiles(resourceMap, outputPath, skipExisting, CheckOnly)
+	if err != nil {
 		fmt.Printf("Error generating mapping files: %v\n", err)
 		os.Exit(1)
 	}
 
-	// Generate summary report
-	generateSummaryReport(resourceMap)
+	if !CheckOnly {
+		// Generate summary report
+		generateSummaryReport(resourceMap)
+	}
+
+	// Resources the provider doesn't have yet keep their mapping, rule generation picks them up once it does
+	resources := loadProviderSchema().ResourceSchemas
+	var pending []string
+	for resourceName := range resourceMap {
+		if _, ok := resources["fabric_"+resourceName]; !ok {
+			pending = append(pending, "fabric_"+resourceName)
+		}
+	}
+	if len(pending) > 0 {
+		sort.Strings(pending)
+		fmt.Printf("\n⏳ %d mappings are pending, their resources are not in schema.json yet:\n", len(pending))
+		for _, resource := range pending {
+			fmt.Printf("  - %s\n", resource)
+		}
+	}
+	return stale
 }
 
 func analyzeSpecForResources(dirName string, relPath string, spec SwaggerSpec) map[string]*ResourceInfo {
@@ -330,6 +410,7 @@ func analyzeSpecForResources(dirName string, relPath string, spec SwaggerSpec) m
 }
 
 func extractConstraints(schema DefinitionSchema, constraints map[string]PropertyConstraints, sourceRequest string, spec *SwaggerSpec) {
+	schema = mergeAllOf(schema, spec, 0)
 	for propName, propSchema := range schema.Properties {
 		// Skip read-only properties
 		if propSchema.ReadOnly {
@@ -341,7 +422,7 @@ func extractConstraints(schema DefinitionSchema, constraints map[string]Property
 			MinLength:      propSchema.MinLength,
 			Pattern:        propSchema.Pattern,
 			Format:         propSchema.Format,
-			Enum:           propSchema.Enum,
+			Enum:           nonNullEnum(propSchema.Enum),
 			Description:    propSchema.Description,
 			SourceRequests: []string{sourceRequest},
 		}
@@ -354,8 +435,8 @@ func extractConstraints(schema DefinitionSchema, constraints map[string]Property
 				refDefName := refParts[len(refParts)-1]
 				if refDef, exists := spec.Definitions[refDefName]; exists {
 					// Use enum values from the referenced definition
-					if len(refDef.Enum) > 0 {
-						constraint.Enum = refDef.Enum
+					if enum := nonNullEnum(refDef.Enum); len(enum) > 0 {
+						constraint.Enum = enum
 					}
 					// Also get other constraints from the ref if not already set
 					if constraint.MaxLength == 0 && refDef.MaxLength > 0 {
@@ -412,6 +493,51 @@ func extractConstraints(schema DefinitionSchema, constraints map[string]Property
 	}
 }
 
+// mergeAllOf returns schema with the properties and required lists of its allOf members added, e.g. the base
+// request an OpenAPI 3 request extends; the schema's own properties win
+func mergeAllOf(schema DefinitionSchema, spec *SwaggerSpec, depth int) DefinitionSchema {
+	if len(schema.AllOf) == 0 || depth > 8 {
+		return schema
+	}
+	merged := schema
+	merged.Properties = make(map[string]PropertySchema, len(schema.Properties))
+	merged.Required = append([]string(nil), schema.Required...)
+	for name, property := range schema.Properties {
+		merged.Properties[name] = property
+	}
+
+	for _, item := range schema.AllOf {
+		var member DefinitionSchema
+		if ref, ok := item["$ref"].(string); ok {
+			// Only local refs, e.g. "#/components/schemas/ItemRequest", are followed
+			if member, ok = spec.Definitions[ref[strings.LastIndex(ref, "/")+1:]]; !ok {
+				continue
+			}
+		} else if raw, err := json.Marshal(item); err != nil || json.Unmarshal(raw, &member) != nil {
+			continue
+		}
+		member = mergeAllOf(member, spec, depth+1)
+		for name, property := range member.Properties {
+			if _, exists := merged.Properties[name]; !exists {
+				merged.Properties[name] = property
+			}
+		}
+		merged.Required = append(merged.Required, member.Required...)
+	}
+	return merged
+}
+
+// nonNullEnum drops the null value OpenAPI 3 nullable enums list, which decodes as ""
+func nonNullEnum(enum []string) []string {
+	var values []string
+	for _, value := range enum {
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 // extractMaxLengthFromDescription extracts max length from description text using various patterns
 func extractMaxLengthFromDescription(description string) int {
 	patterns := []string{
@@ -437,9 +563,13 @@ func extractMaxLengthFromDescription(description string) int {
 	return 0
 }
 
-func generateMappingFiles(resourceMap map[string]*ResourceInfo, outputPath string, skipExisting bool) error {
-	if err := os.MkdirAll(outputPath, 0755); err != nil {
-		return err
+// generateMappingFiles writes a mapping per resource, or with check only reports the differences, and returns the
+// number of mapping files that were or would be created or updated
+func generateMappingFiles(resourceMap map[string]*ResourceInfo, outputPath string, skipExisting, check bool) (int, error) {
+	if !check {
+		if err := os.MkdirAll(outputPath, 0755); err != nil {
+			return 0, err
+		}
 	}
 
 	// Sort resource names for consistent output
@@ -449,7 +579,8 @@ func generateMappingFiles(resourceMap map[string]*ResourceInfo, outputPath strin
 	}
 	sort.Strings(resourceNames)
 
-	created, skipped, updated := 0, 0, 0
+	created, skipped, updated, unchanged := 0, 0, 0, 0
+	generated := make(map[string]bool)
 
 	for _, resourceName := range resourceNames {
 		info := resourceMap[resourceName]
@@ -458,9 +589,10 @@ func generateMappingFiles(resourceMap map[string]*ResourceInfo, outputPath strin
 		// Just add the fabric_ prefix
 		tfResourceName := "fabric_" + resourceName
 
-		status, err := generateMappingFile(tfResourceName, resourceName, info, outputPath, skipExisting)
+		generated[tfResourceName+".hcl"] = true
+		status, err := generateMappingFile(tfResourceName, resourceName, info, outputPath, skipExisting, check)
 		if err != nil {
-			return fmt.Errorf("error generating mapping for %s: %v", resourceName, err)
+			return 0, fmt.Errorf("error generating mapping for %s: %v", resourceName, err)
 		}
 
 		switch status {
@@ -470,12 +602,28 @@ func generateMappingFiles(resourceMap map[string]*ResourceInfo, outputPath strin
 			skipped++
 		case "updated":
 			updated++
+		case "unchanged":
+			unchanged++
 		}
 	}
 
+	if check {
+		fmt.Printf("\n🔍 Mapping files: %d would be created, %d updated, %d unchanged, %d skipped in %s\n",
+			created, updated, unchanged, skipped, outputPath)
+
+		// Hand-written mappings and mappings of resources removed from the specs are not regenerated
+		existing, _ := filepath.Glob(filepath.Join(outputPath, "*.hcl"))
+		for _, file := range existing {
+			if !generated[filepath.Base(file)] {
+				fmt.Printf("  ⚠️  %s is not generated from the specs (hand-written, or removed from the specs)\n", filepath.Base(file))
+			}
+		}
+		return created + updated, nil
+	}
+
 	fmt.Printf("\n✅ Mapping files: %d created, %d skipped, %d updated in %s\n",
 		created, skipped, updated, outputPath)
-	return nil
+	return created + updated, nil
 }
 
 // extractRequestTypeSuffix extracts a meaningful suffix from a request type name
@@ -524,9 +672,79 @@ func parseExistingMapping(filename string) (map[string]*existingAttributeMapping
 		}
 	}
 
+	manualComments := parseManualComments(f.Bytes)
+	for name, attr := range existingAttrs {
+		attr.ManualComments = manualComments[name]
+	}
+
 	return existingAttrs, nil
 }
 
+// parseExistingConstraints returns the constraint, data_source and variants blocks of an existing mapping file as written,
+// including the comment lines directly above each block
+// These blocks never come from the API spec, so they are copied into the regenerated file unchanged
+func parseExistingConstraints(filename string) ([]string, error) {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	f, diags := hclsyntax.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("failed to parse HCL: %v", diags)
+	}
+
+	lines := strings.Split(string(src), "\n")
+	var constraints []string
+	for _, mapping := range f.Body.(*hclsyntax.Body).Blocks {
+		if mapping.Type != "mapping" {
+			continue
+		}
+		for _, block := range mapping.Body.Blocks {
+			if block.Type != "constraint" && block.Type != "data_source" && block.Type != "variants" {
+				continue
+			}
+			rng := block.Range()
+			// Include the comment lines directly above the block
+			first := rng.Start.Line - 1
+			for first > 0 && strings.HasPrefix(strings.TrimSpace(lines[first-1]), "//") {
+				first--
+			}
+			constraints = append(constraints, strings.Join(lines[first:rng.End.Line], "\n"))
+		}
+	}
+
+	return constraints, nil
+}
+
+// attributeLinePattern matches the opening line of an attribute block
+var attributeLinePattern = regexp.MustCompile(`^attribute\s+"([^"]+)"\s*\{`)
+
+// parseManualComments returns the "// MANUAL:" comment lines written directly above each attribute block
+// Comments are not part of the decoded HCL body, so they are read from the raw file
+func parseManualComments(src []byte) map[string][]string {
+	comments := make(map[string][]string)
+	var pending []string
+
+	for _, line := range strings.Split(string(src), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "// MANUAL:"):
+			pending = append(pending, line)
+		case strings.HasPrefix(line, "//"):
+			// Auto-generated comments between MANUAL comments and the attribute are regenerated
+		case attributeLinePattern.MatchString(line):
+			if len(pending) > 0 {
+				comments[attributeLinePattern.FindStringSubmatch(line)[1]] = pending
+			}
+			pending = nil
+		default:
+			pending = nil
+		}
+	}
+
+	return comments
+}
+
 // mergeConstraints merges API spec constraints with existing manual constraints
 // Manual constraints (from existing file) take precedence
 func mergeConstraints(apiConstraint PropertyConstraints, existing *existingAttributeMapping) PropertyConstraints {
@@ -546,12 +764,41 @@ func mergeConstraints(apiConstraint PropertyConstraints, existing *existingAttri
 		if len(existing.ValidValues) > 0 {
 			merged.Enum = existing.ValidValues
 		}
+		applyManualOnlyConstraints(&merged, existing)
 	}
 
 	return merged
 }
 
-func generateMappingFile(tfResourceName, specDir string, info *ResourceInfo, outputPath string, skipExisting bool) (string, error) {
+// applyManualOnlyConstraints copies constraints and rule settings that only exist in mapping files, never in the API spec
+func applyManualOnlyConstraints(constraint *PropertyConstraints, existing *existingAttributeMapping) {
+	if existing.PatternMessage != nil {
+		constraint.PatternMessage = *existing.PatternMessage
+	}
+	if existing.AllowedCharacters != nil {
+		constraint.AllowedCharacters = *existing.AllowedCharacters
+	}
+	constraint.ReservedWords = existing.ReservedWords
+	constraint.ManualComments = existing.ManualComments
+
+	constraint.WarnOnExceed = existing.WarnOnExceed
+	constraint.Enabled = existing.Enabled
+	if existing.Severity != nil {
+		constraint.Severity = *existing.Severity
+	}
+	if existing.Message != nil {
+		constraint.Message = *existing.Message
+	}
+	if existing.Link != nil {
+		constraint.Link = *existing.Link
+	}
+	if existing.Shared != nil {
+		constraint.Shared = *existing.Shared
+	}
+	constraint.ManualDoc = existing.ManualDoc
+}
+
+func generateMappingFile(tfResourceName, specDir string, info *ResourceInfo, outputPath string, skipExisting, check bool) (string, error) {
 	filename := filepath.Join(outputPath, tfResourceName+".hcl")
 
 	// Parse existing mapping file if it exists
@@ -580,6 +827,16 @@ func generateMappingFile(tfResourceName, specDir string, info *ResourceInfo, out
 		existingAttrs = make(map[string]*existingAttributeMapping)
 	}
 
+	var existingConstraints []string
+	if fileExists {
+		var err error
+		if existingConstraints, err = parseExistingConstraints(filename); err != nil {
+			fmt.Printf("  ⚠️  Warning: could not read constraints of existing %s: %v\n", tfResourceName, err)
+		} else if len(existingConstraints) > 0 {
+			fmt.Printf("    📌 Preserving %d hand-written block(s)\n", len(existingConstraints))
+		}
+	}
+
 	// Merge constraints: existing manual constraints take precedence
 	mergedConstraints := make(map[string]PropertyConstraints)
 	allAttrNames := make(map[string]bool)
@@ -617,6 +874,7 @@ func generateMappingFile(tfResourceName, specDir string, info *ResourceInfo, out
 			if len(existingAttr.ValidValues) > 0 {
 				manualConstraint.Enum = existingAttr.ValidValues
 			}
+			applyManualOnlyConstraints(&manualConstraint, existingAttr)
 
 			// Use the attribute name itself as the key (with :manual suffix for tracking)
 			mergedConstraints[attrName+":manual"] = manualConstraint
@@ -695,6 +953,9 @@ func generateMappingFile(tfResourceName, specDir string, info *ResourceInfo, out
 			comment += fmt.Sprintf(", enum(%d values)", len(constraint.Enum))
 		}
 		content.WriteString(fmt.Sprintf("  // %s\n", comment))
+		for _, manualComment := range constraint.ManualComments {
+			content.WriteString(fmt.Sprintf("  %s\n", manualComment))
+		}
 		content.WriteString(fmt.Sprintf("  attribute \"%s\" {\n", tfAttrName))
 		if sourceRequest == "manual" && constraint.ApiRef != "" {
 			content.WriteString(fmt.Sprintf("    api_ref = \"%s\"\n", constraint.ApiRef))
@@ -705,6 +966,9 @@ func generateMappingFile(tfResourceName, specDir string, info *ResourceInfo, out
 			}
 			content.WriteString(fmt.Sprintf("    api_ref = \"%s.%s\"\n", requestType, propName))
 		}
+		if constraint.Shared != "" {
+			content.WriteString(fmt.Sprintf("    shared = %q\n", constraint.Shared))
+		}
 
 		// Add constraints if present
 		if constraint.MaxLength > 0 {
@@ -714,10 +978,26 @@ func generateMappingFile(tfResourceName, specDir string, info *ResourceInfo, out
 			content.WriteString(fmt.Sprintf("    min_length = %d\n", constraint.MinLength))
 		}
 		if constraint.Pattern != "" {
-			// Escape backslashes for HCL
-			escapedPattern := strings.ReplaceAll(constraint.Pattern, `\`, `\\`)
+			// Escape backslashes and quotes for HCL
+			escapedPattern := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(constraint.Pattern)
 			content.WriteString(fmt.Sprintf("    pattern = \"%s\"\n", escapedPattern))
 		}
+		if constraint.PatternMessage != "" {
+			content.WriteString(fmt.Sprintf("    pattern_message = %q\n", constraint.PatternMessage))
+		}
+		if constraint.AllowedCharacters != "" {
+			content.WriteString(fmt.Sprintf("    allowed_characters = %q\n", constraint.AllowedCharacters))
+		}
+		if len(constraint.ReservedWords) > 0 {
+			content.WriteString("    reserved_words = [")
+			for i, word := range constraint.ReservedWords {
+				if i > 0 {
+					content.WriteString(", ")
+				}
+				content.WriteString(fmt.Sprintf("%q", word))
+			}
+			content.WriteString("]\n")
+		}
 		if len(constraint.Enum) > 0 {
 			// Write enum values as an HCL list
 			content.WriteString("    valid_values = [")
@@ -729,10 +1009,33 @@ func generateMappingFile(tfResourceName, specDir string, info *ResourceInfo, out
 			}
 			content.WriteString("]\n")
 		}
+		if constraint.WarnOnExceed != nil {
+			content.WriteString(fmt.Sprintf("    warn_on_exceed = %t\n", *constraint.WarnOnExceed))
+		}
+		if constraint.Severity != "" {
+			content.WriteString(fmt.Sprintf("    severity = %q\n", constraint.Severity))
+		}
+		if constraint.Enabled != nil {
+			content.WriteString(fmt.Sprintf("    enabled = %t\n", *constraint.Enabled))
+		}
+		if constraint.Message != "" {
+			content.WriteString(fmt.Sprintf("    message = %q\n", constraint.Message))
+		}
+		if constraint.Link != "" {
+			content.WriteString(fmt.Sprintf("    link = %q\n", constraint.Link))
+		}
+		if constraint.ManualDoc != nil {
+			content.WriteString(fmt.Sprintf("    manual_doc = %t\n", *constraint.ManualDoc))
+		}
 
 		content.WriteString("  }\n\n")
 	}
 
+	for _, constraint := range existingConstraints {
+		content.WriteString(constraint)
+		content.WriteString("\n\n")
+	}
+
 	content.WriteString("  // Add manual customizations below with // MANUAL: comment\n")
 	content.WriteString("  // Example:\n")
 	content.WriteString("  // // MANUAL: custom constraint\n")
@@ -744,6 +1047,10 @@ func generateMappingFile(tfResourceName, specDir string, info *ResourceInfo, out
 	content.WriteString("  // }\n")
 	content.WriteString("}\n")
 
+	if check {
+		return checkMappingFile(filename, content.String(), fileExists), nil
+	}
+
 	if err := os.WriteFile(filename, []byte(content.String()), 0644); err != nil {
 		return "", err
 	}
@@ -754,6 +1061,77 @@ func generateMappingFile(tfResourceName, specDir string, info *ResourceInfo, out
 	return "created", nil
 }
 
+// checkMappingFile prints how the regenerated content of a mapping file differs from the file on disk and returns
+// "created", "updated" or "unchanged", without writing anything
+func checkMappingFile(filename, content string, fileExists bool) string {
+	if !fileExists {
+		fmt.Printf("  ✨ Would create %s\n", filepath.Base(filename))
+		return "created"
+	}
+	existing, err := os.ReadFile(filename)
+	if err == nil && string(existing) == content {
+		return "unchanged"
+	}
+
+	oldAttrs, newAttrs := mappedAttributes(string(existing)), mappedAttributes(content)
+	for _, name := range sortedKeys(newAttrs) {
+		if !oldAttrs[name] {
+			fmt.Printf("    + attribute %q is new in the API spec\n", name)
+		}
+	}
+	for _, name := range sortedKeys(oldAttrs) {
+		if !newAttrs[name] {
+			fmt.Printf("    - attribute %q would be removed\n", name)
+		}
+	}
+
+	// Lines only in one version, e.g. a changed max_length
+	oldLines, newLines := lineSet(string(existing)), lineSet(content)
+	for _, line := range sortedKeys(oldLines) {
+		if !newLines[line] {
+			fmt.Printf("    - %s\n", line)
+		}
+	}
+	for _, line := range sortedKeys(newLines) {
+		if !oldLines[line] {
+			fmt.Printf("    + %s\n", line)
+		}
+	}
+	fmt.Printf("  ♻️  Would update %s\n", filepath.Base(filename))
+	return "updated"
+}
+
+// mappedAttributes returns the names of the attribute blocks of a mapping file
+func mappedAttributes(content string) map[string]bool {
+	names := make(map[string]bool)
+	for _, line := range strings.Split(content, "\n") {
+		if m := attributeLinePattern.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+			names[m[1]] = true
+		}
+	}
+	return names
+}
+
+// lineSet returns the trimmed non-empty lines of content
+func lineSet(content string) map[string]bool {
+	lines := make(map[string]bool)
+	for _, line := range strings.Split(content, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines[line] = true
+		}
+	}
+	return lines
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func generateComparisonReport(resourceName, existingContent string, info *ResourceInfo, outputPath string) {
 	reportFile := filepath.Join(outputPath, resourceName+".diff.txt")
 
@@ -843,53 +1221,3 @@ func generateSummaryReport(resourceMap map[string]*ResourceInfo) {
 		}
 	}
 }
-
-func toSnakeCase(s string) string {
-	// Handle common acronyms first
-	acronyms := map[string]string{
-		"SQL":     "sql",
-		"KQL":     "kql",
-		"ML":      "ml",
-		"API":     "api",
-		"ID":      "id",
-		"URL":     "url",
-		"URI":     "uri",
-		"HTTP":    "http",
-		"HTTPS":   "https",
-		"GraphQL": "graphql",
-	}
-
-	// Check if the entire string is an acronym
-	if replacement, exists := acronyms[s]; exists {
-		return replacement
-	}
-
-	// Replace known acronyms within the string
-	result := s
-	for acronym, replacement := range acronyms {
-		result = strings.ReplaceAll(result, acronym, strings.Title(replacement))
-	}
-
-	// Now convert camelCase/PascalCase to snake_case
-	var snake []rune
-	runes := []rune(result)
-	for i := 0; i < len(runes); i++ {
-		r := runes[i]
-
-		// Add underscore before uppercase letter if:
-		// 1. Not the first character
-		// 2. Previous character is lowercase OR next character is lowercase (handles acronyms)
-		if i > 0 && r >= 'A' && r <= 'Z' {
-			prevIsLower := runes[i-1] >= 'a' && runes[i-1] <= 'z'
-			nextIsLower := i+1 < len(runes) && runes[i+1] >= 'a' && runes[i+1] <= 'z'
-
-			if prevIsLower || nextIsLower {
-				snake = append(snake, '_')
-			}
-		}
-
-		snake = append(snake, r)
-	}
-
-	return strings.ToLower(string(snake))
-}
@@ -22,6 +22,13 @@ mapping "fabric_activator" {
     api_ref = "CreateReflexRequest.folderId"
   }
 
+  // MANUAL: data "fabric_activator" is looked up by id or display_name
+  data_source "fabric_activator" {
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
@@ -24,6 +24,13 @@ mapping "fabric_apache_airflow_job" {
     api_ref = "CreateApacheAirflowJobRequest.folderId"
   }
 
+  // MANUAL: data "fabric_apache_airflow_job" is looked up by id or display_name
+  data_source "fabric_apache_airflow_job" {
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
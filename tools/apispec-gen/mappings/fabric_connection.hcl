@@ -18,6 +18,24 @@ mapping "fabric_connection" {
     valid_values = ["ShareableCloud", "PersonalCloud", "OnPremisesGateway", "OnPremisesGatewayPersonal", "VirtualNetworkGateway", "Automatic", "None"]
   }
 
+  // MANUAL: nested credential_details attributes
+  attribute "credential_details.connection_encryption" {
+    api_ref = "CreateConnectionRequest.credentialDetails.connectionEncryption"
+    valid_values = ["Any", "Encrypted", "NotEncrypted"]
+  }
+
+  // MANUAL: nested credential_details attributes
+  attribute "credential_details.credential_type" {
+    api_ref = "CreateConnectionRequest.credentialDetails.credentials.credentialType"
+    valid_values = ["Anonymous", "Basic", "Key", "OAuth2", "ServicePrincipal", "SharedAccessSignature", "Windows", "WindowsWithoutImpersonation", "WorkspaceIdentity"]
+  }
+
+  // MANUAL: nested credential_details attributes
+  attribute "credential_details.single_sign_on_type" {
+    api_ref = "CreateConnectionRequest.credentialDetails.singleSignOnType"
+    valid_values = ["None", "Kerberos", "MicrosoftEntraID", "SecurityAssertionMarkupLanguage", "KerberosDirectQueryAndRefresh"]
+  }
+
   // required
   attribute "credential_details_cloud" {
     api_ref = "CreateCloudConnectionRequest.credentialDetails"
@@ -105,6 +123,32 @@ mapping "fabric_connection" {
     valid_values = ["None", "Private", "Organizational", "Public"]
   }
 
+  // MANUAL: gateway_id is REQUIRED for VirtualNetworkGateway and NULL for ShareableCloud connections
+  constraint "gateway_id_required" {
+    required_if    = ["gateway_id"]
+    when_attribute = "connectivity_type"
+    when_equals    = "VirtualNetworkGateway"
+  }
+
+  constraint "gateway_id_forbidden" {
+    forbidden_if   = ["gateway_id"]
+    when_attribute = "connectivity_type"
+    when_equals    = "ShareableCloud"
+  }
+
+  // MANUAL: each credential_type needs its matching credentials object
+  variants "credential_type" {
+    api_ref       = "CreateConnectionRequest.credentialDetails.credentials"
+    discriminator = "credential_details.credential_type"
+    object        = "credential_details.{variant}_credentials"
+  }
+
+  // MANUAL: write-only passwords are only sent when the version changes
+  constraint "basic_credentials_password_wo_required" {
+    required_with = ["credential_details.basic_credentials.password_wo"]
+    attribute     = "credential_details.basic_credentials.password_wo_version"
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
@@ -24,6 +24,15 @@ mapping "fabric_copy_job" {
     api_ref = "CreateCopyJobRequest.folderId"
   }
 
+  // MANUAL: data "fabric_copy_job" is looked up by id or display_name
+  data_source "fabric_copy_job" {
+    attributes = ["display_name"]
+
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
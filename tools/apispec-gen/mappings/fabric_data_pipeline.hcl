@@ -24,6 +24,15 @@ mapping "fabric_data_pipeline" {
     api_ref = "CreateDataPipelineRequest.folderId"
   }
 
+  // MANUAL: data "fabric_data_pipeline" is looked up by id or display_name
+  data_source "fabric_data_pipeline" {
+    attributes = ["display_name"]
+
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
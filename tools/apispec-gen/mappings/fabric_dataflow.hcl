@@ -24,6 +24,15 @@ mapping "fabric_dataflow" {
     api_ref = "CreateDataflowRequest.folderId"
   }
 
+  // MANUAL: data "fabric_dataflow" is looked up by id or display_name
+  data_source "fabric_dataflow" {
+    attributes = ["display_name"]
+
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
@@ -23,6 +23,15 @@ mapping "fabric_deployment_pipeline" {
     api_ref = "CreateDeploymentPipelineRequest.stages"
   }
 
+  // MANUAL: data "fabric_deployment_pipeline" is looked up by id or display_name
+  data_source "fabric_deployment_pipeline" {
+    attributes = ["display_name"]
+
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
@@ -0,0 +1,26 @@
+// Mapping for fabric_deployment_pipeline_role_assignment resource
+// Written by hand: apispec-gen mappings only generates from Create*Request schemas, this uses AddDeploymentPipelineRoleAssignmentRequest
+// The api_refs resolve to the role and principal type enums of platform/definitions/deploymentPipelines.json, so the values follow the spec
+
+mapping "fabric_deployment_pipeline_role_assignment" {
+  import_path = "platform/definitions/deploymentPipelines.json"
+
+  attribute "principal.type" {
+    api_ref = "AddDeploymentPipelineRoleAssignmentRequest.principal.type"
+  }
+
+  // required, enum(1 values)
+  attribute "role" {
+    api_ref = "AddDeploymentPipelineRoleAssignmentRequest.role"
+  }
+
+  // Add manual customizations below with // MANUAL: comment
+  // Example:
+  // // MANUAL: custom constraint
+  // attribute "display_name" {
+  //   api_ref = "CreateXxxRequest.displayName"
+  //   max_length = 256
+  //   pattern = "^[a-zA-Z0-9_]+$"
+  //   warn_on_exceed = true
+  // }
+}
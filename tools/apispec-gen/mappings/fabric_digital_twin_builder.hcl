@@ -17,6 +17,13 @@ mapping "fabric_digital_twin_builder" {
     api_ref = "CreateDigitalTwinBuilderRequest.displayName"
   }
 
+  // MANUAL: data "fabric_digital_twin_builder" is looked up by id or display_name
+  data_source "fabric_digital_twin_builder" {
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
@@ -0,0 +1,30 @@
+// Mapping for fabric_domain_role_assignments resource
+// Written by hand: apispec-gen mappings only generates from Create*Request schemas, this uses DomainRoleAssignmentRequest
+// The api_refs resolve to the role and principal type enums of admin/definitions/domains.json, so the values follow the spec
+
+mapping "fabric_domain_role_assignments" {
+  import_path = "admin/definitions/domains.json"
+
+  // MANUAL: the API calls the role "type" on the bulk assign request
+  // required, enum(2 values)
+  attribute "role" {
+    api_ref = "DomainRoleAssignmentRequest.type"
+  }
+
+  // MANUAL: fabric_domain_role_assignments_principals reads the types from the catalog and reports the entry
+  // MANUAL: the generator filters them to the types schema.json allows
+  attribute "principals.type" {
+    api_ref = "DomainRoleAssignmentRequest.principals.type"
+    enabled = false
+  }
+
+  // Add manual customizations below with // MANUAL: comment
+  // Example:
+  // // MANUAL: custom constraint
+  // attribute "display_name" {
+  //   api_ref = "CreateXxxRequest.displayName"
+  //   max_length = 256
+  //   pattern = "^[a-zA-Z0-9_]+$"
+  //   warn_on_exceed = true
+  // }
+}
@@ -22,6 +22,13 @@ mapping "fabric_environment" {
     api_ref = "CreateEnvironmentRequest.folderId"
   }
 
+  // MANUAL: data "fabric_environment" is looked up by id or display_name
+  data_source "fabric_environment" {
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
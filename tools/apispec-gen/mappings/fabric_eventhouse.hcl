@@ -26,7 +26,16 @@ mapping "fabric_eventhouse" {
     api_ref = "CreateEventhouseRequest.folderId"
   }
 
-   // Add manual customizations below with // MANUAL: comment
+   // MANUAL: data "fabric_eventhouse" is looked up by id or display_name
+  data_source "fabric_eventhouse" {
+    attributes = ["display_name"]
+
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
+  // Add manual customizations below with // MANUAL: comment
  
   // MANUAL: required, format: uuid
   // Workspace that owns the Eventhouse
@@ -26,6 +26,15 @@ mapping "fabric_eventstream" {
     api_ref = "CreateEventstreamRequest.folderId"
   }
 
+  // MANUAL: data "fabric_eventstream" is looked up by id or display_name
+  data_source "fabric_eventstream" {
+    attributes = ["display_name"]
+
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
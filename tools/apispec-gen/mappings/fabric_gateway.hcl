@@ -95,6 +95,13 @@ mapping "fabric_gateway" {
     api_ref = "CreateVirtualNetworkGatewayRequest.virtualNetworkAzureResource"
   }
 
+  // MANUAL: data "fabric_gateway" is looked up by id or display_name
+  data_source "fabric_gateway" {
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
@@ -0,0 +1,26 @@
+// Mapping for fabric_gateway_role_assignment resource
+// Written by hand: apispec-gen mappings only generates from Create*Request schemas, this uses AddGatewayRoleAssignmentRequest
+// The api_refs resolve to the role and principal type enums of platform/definitions/gateways.json, so the values follow the spec
+
+mapping "fabric_gateway_role_assignment" {
+  import_path = "platform/definitions/gateways.json"
+
+  attribute "principal.type" {
+    api_ref = "AddGatewayRoleAssignmentRequest.principal.type"
+  }
+
+  // required, enum(3 values)
+  attribute "role" {
+    api_ref = "AddGatewayRoleAssignmentRequest.role"
+  }
+
+  // Add manual customizations below with // MANUAL: comment
+  // Example:
+  // // MANUAL: custom constraint
+  // attribute "display_name" {
+  //   api_ref = "CreateXxxRequest.displayName"
+  //   max_length = 256
+  //   pattern = "^[a-zA-Z0-9_]+$"
+  //   warn_on_exceed = true
+  // }
+}
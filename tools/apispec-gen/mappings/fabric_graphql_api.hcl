@@ -22,6 +22,13 @@ mapping "fabric_graphql_api" {
     api_ref = "CreateGraphQLApiRequest.folderId"
   }
 
+  // MANUAL: data "fabric_graphql_api" is looked up by id or display_name
+  data_source "fabric_graphql_api" {
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
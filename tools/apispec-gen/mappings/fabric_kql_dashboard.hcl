@@ -22,6 +22,13 @@ mapping "fabric_kql_dashboard" {
     api_ref = "CreateKQLDashboardRequest.folderId"
   }
 
+  // MANUAL: data "fabric_kql_dashboard" is looked up by id or display_name
+  data_source "fabric_kql_dashboard" {
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
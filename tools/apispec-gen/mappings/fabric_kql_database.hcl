@@ -13,8 +13,13 @@ mapping "fabric_kql_database" {
   }
 
   // required
+  // MANUAL: Kusto database names allow letters, digits, spaces, periods, hyphens and underscores;
+  // $systemdb is reserved by the engine
   attribute "display_name" {
     api_ref = "CreateKQLDatabaseRequest.displayName"
+    pattern = "^[a-zA-Z0-9 ._-]+$"
+    pattern_message = "can contain only letters, numbers, spaces, periods, hyphens and underscores"
+    reserved_words = ["$systemdb"]
   }
 
   // optional, format: uuid
@@ -22,6 +27,15 @@ mapping "fabric_kql_database" {
     api_ref = "CreateKQLDatabaseRequest.folderId"
   }
 
+  // MANUAL: data "fabric_kql_database" is looked up by id or display_name
+  data_source "fabric_kql_database" {
+    attributes = ["display_name"]
+
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
@@ -7,15 +7,22 @@ mapping "fabric_lakehouse" {
   import_path = "lakehouse/definitions.json"
 
   // optional, max 256 chars
+  // MANUAL: the description doesn't change what the lakehouse does, so a long one is a warning that says by how much
   attribute "description" {
     api_ref = "CreateLakehouseRequest.description"
     max_length = 256
+    severity = "warning"
+    message = "description is {actual} characters, lakehouse descriptions are limited to {limit}"
   }
 
   // required, max 123 chars
+  // MANUAL: lakehouse names become Spark and SQL endpoint identifiers
   attribute "display_name" {
     api_ref = "CreateLakehouseRequest.displayName"
     max_length = 123
+    pattern = "^[a-zA-Z][a-zA-Z0-9_]*$"
+    pattern_message = "must start with a letter and contain only letters, numbers and underscores"
+    allowed_characters = "a-zA-Z0-9_"
   }
 
   // optional, format: uuid
@@ -23,6 +30,15 @@ mapping "fabric_lakehouse" {
     api_ref = "CreateLakehouseRequest.folderId"
   }
 
+  // MANUAL: data "fabric_lakehouse" is looked up by id or display_name
+  data_source "fabric_lakehouse" {
+    attributes = ["display_name"]
+
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
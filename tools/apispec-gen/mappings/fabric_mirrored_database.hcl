@@ -27,6 +27,13 @@ mapping "fabric_mirrored_database" {
     api_ref = "CreateMirroredDatabaseRequest.folderId"
   }
 
+  // MANUAL: data "fabric_mirrored_database" is looked up by id or display_name
+  data_source "fabric_mirrored_database" {
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
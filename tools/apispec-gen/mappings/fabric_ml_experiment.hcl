@@ -22,6 +22,13 @@ mapping "fabric_ml_experiment" {
     api_ref = "CreateMLExperimentRequest.folderId"
   }
 
+  // MANUAL: data "fabric_ml_experiment" is looked up by id or display_name
+  data_source "fabric_ml_experiment" {
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
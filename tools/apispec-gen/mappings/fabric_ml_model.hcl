@@ -22,6 +22,13 @@ mapping "fabric_ml_model" {
     api_ref = "CreateMLModelRequest.folderId"
   }
 
+  // MANUAL: data "fabric_ml_model" is looked up by id or display_name
+  data_source "fabric_ml_model" {
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
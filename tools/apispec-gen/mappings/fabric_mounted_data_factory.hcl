@@ -23,6 +23,13 @@ mapping "fabric_mounted_data_factory" {
     api_ref = "CreateMountedDataFactoryRequest.folderId"
   }
 
+  // MANUAL: data "fabric_mounted_data_factory" is looked up by id or display_name
+  data_source "fabric_mounted_data_factory" {
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
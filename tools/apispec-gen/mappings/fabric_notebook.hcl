@@ -28,6 +28,15 @@ mapping "fabric_notebook" {
     api_ref = "CreateNotebookRequest.folderId"
   }
 
+  // MANUAL: data "fabric_notebook" is looked up by id or display_name
+  data_source "fabric_notebook" {
+    attributes = ["display_name"]
+
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
@@ -21,6 +21,29 @@ mapping "fabric_shortcut" {
     api_ref = "CreateShortcutRequest.target"
   }
 
+  // MANUAL: OneLake paths are relative to the item root and can't start with '/'
+  attribute "target.onelake.path" {
+    api_ref = "CreateShortcutRequest.target.oneLake.path"
+    max_length = 256
+    pattern = "^[^/]"
+    pattern_message = "must be relative to the item root and not start with '/'"
+  }
+
+  // MANUAL: the target must specify exactly one destination
+  // MANUAL: no variants block, target.type is computed so no configuration sets a discriminator; schema.json requires the fields of each destination
+  constraint "target_destination" {
+    one_of = [
+      "target.adls_gen2",
+      "target.amazon_s3",
+      "target.azure_blob_storage",
+      "target.dataverse",
+      "target.external_data_share",
+      "target.google_cloud_storage",
+      "target.onelake",
+      "target.s3_compatible",
+    ]
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
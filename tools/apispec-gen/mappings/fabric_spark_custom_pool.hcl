@@ -33,6 +33,13 @@ mapping "fabric_spark_custom_pool" {
     valid_values = ["Small", "Medium", "Large", "XLarge", "XXLarge"]
   }
 
+  // MANUAL: data "fabric_spark_custom_pool" is looked up by id or name
+  data_source "fabric_spark_custom_pool" {
+    constraint "lookup" {
+      one_of = ["id", "name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
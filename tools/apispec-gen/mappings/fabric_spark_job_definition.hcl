@@ -24,6 +24,15 @@ mapping "fabric_spark_job_definition" {
     api_ref = "CreateSparkJobDefinitionRequest.folderId"
   }
 
+  // MANUAL: data "fabric_spark_job_definition" is looked up by id or display_name
+  data_source "fabric_spark_job_definition" {
+    attributes = ["display_name"]
+
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
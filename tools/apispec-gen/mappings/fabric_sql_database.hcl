@@ -13,8 +13,12 @@ mapping "fabric_sql_database" {
   }
 
   // required
+  // MANUAL: SQL reserved characters and system database names are rejected, see mappings/shared/sql_database_names.hcl
+  // MANUAL: the docs of the display_name rules are hand-written
   attribute "display_name" {
     api_ref = "CreateSQLDatabaseRequest.displayName"
+    shared = "sql_database_name"
+    manual_doc = true
   }
 
   // optional, format: uuid
@@ -22,6 +26,15 @@ mapping "fabric_sql_database" {
     api_ref = "CreateSQLDatabaseRequest.folderId"
   }
 
+  // MANUAL: data "fabric_sql_database" is looked up by id or display_name
+  data_source "fabric_sql_database" {
+    attributes = ["display_name"]
+
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
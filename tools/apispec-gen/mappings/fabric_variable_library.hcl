@@ -22,6 +22,13 @@ mapping "fabric_variable_library" {
     api_ref = "CreateVariableLibraryRequest.folderId"
   }
 
+  // MANUAL: data "fabric_variable_library" is looked up by id or display_name
+  data_source "fabric_variable_library" {
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
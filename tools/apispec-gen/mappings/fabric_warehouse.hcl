@@ -13,8 +13,12 @@ mapping "fabric_warehouse" {
   }
 
   // required
+  // MANUAL: warehouse names are SQL database names, see mappings/shared/sql_database_names.hcl
+  // MANUAL: the docs of the display_name rules are hand-written
   attribute "display_name" {
     api_ref = "CreateWarehouseRequest.displayName"
+    shared = "sql_database_name"
+    manual_doc = true
   }
 
   // optional, format: uuid
@@ -22,6 +26,15 @@ mapping "fabric_warehouse" {
     api_ref = "CreateWarehouseRequest.folderId"
   }
 
+  // MANUAL: data "fabric_warehouse" is looked up by id or display_name
+  data_source "fabric_warehouse" {
+    attributes = ["display_name"]
+
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
@@ -22,6 +22,13 @@ mapping "fabric_warehouse_snapshot" {
     api_ref = "CreateWarehouseSnapshotRequest.folderId"
   }
 
+  // MANUAL: data "fabric_warehouse_snapshot" is looked up by id or display_name
+  data_source "fabric_warehouse_snapshot" {
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
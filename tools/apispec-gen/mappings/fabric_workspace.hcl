@@ -28,6 +28,15 @@ mapping "fabric_workspace" {
     api_ref = "CreateWorkspaceRequest.domainId"
   }
 
+  // MANUAL: data "fabric_workspace" is looked up by id or display_name
+  data_source "fabric_workspace" {
+    attributes = ["display_name"]
+
+    constraint "lookup" {
+      one_of = ["id", "display_name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
@@ -6,9 +6,20 @@
 mapping "fabric_workspace_managed_private_endpoint" {
   import_path = "platform/definitions/managedPrivateEndpoint.json"
 
-  // required
+  // required, max 64 chars
+  // MANUAL: endpoint names follow the Azure private endpoint naming rules
   attribute "name" {
     api_ref = "CreateManagedPrivateEndpointRequest.name"
+    max_length = 64
+    pattern = "^[a-zA-Z0-9]([a-zA-Z0-9_.-]*[a-zA-Z0-9_])?$"
+    pattern_message = "must start with a letter or number, end with a letter, number or underscore, and contain only letters, numbers, underscores, periods and hyphens"
+    allowed_characters = "a-zA-Z0-9_.-"
+  }
+
+  // optional, max 140 chars
+  attribute "request_message" {
+    api_ref = "CreateManagedPrivateEndpointRequest.requestMessage"
+    max_length = 140
   }
 
   // required
@@ -16,6 +27,15 @@ mapping "fabric_workspace_managed_private_endpoint" {
     api_ref = "CreateManagedPrivateEndpointRequest.targetPrivateLinkResourceId"
   }
 
+  // MANUAL: data "fabric_workspace_managed_private_endpoint" is looked up by id or name
+  data_source "fabric_workspace_managed_private_endpoint" {
+    attributes = ["name"]
+
+    constraint "lookup" {
+      one_of = ["id", "name"]
+    }
+  }
+
   // Add manual customizations below with // MANUAL: comment
   // Example:
   // // MANUAL: custom constraint
@@ -0,0 +1,9 @@
+// Constraints shared by attribute mappings of several resources
+// Use them from an attribute with shared = "<name>"; constraints set on the attribute win.
+
+// Warehouses and SQL databases are SQL databases, so SQL reserved characters and system database names are rejected
+shared "sql_database_name" {
+  pattern = "^[^/\\\\:*?\"<>|#%]+$"
+  pattern_message = "must not contain any of the characters / \\ : * ? \" < > | # %"
+  reserved_words = ["master", "model", "msdb", "tempdb"]
+}
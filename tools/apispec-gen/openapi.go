@@ -0,0 +1,362 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Specs are Swagger 2.0 or OpenAPI 3 documents. Schemas are read through specResolver, which follows $refs across
+// files, merges allOf members and finds the variants of oneOf/anyOf schemas and discriminators.
+
+// maxRefDepth bounds $ref chains and allOf nesting, which may be cyclic
+const maxRefDepth = 32
+
+// specs caches the spec files read during a run, shared by mappings importing the same file
+var specs = &specResolver{docs: make(map[string]map[string]interface{})}
+
+type specResolver struct {
+	// docs are parsed spec files keyed by path relative to -specs-path
+	docs map[string]map[string]interface{}
+}
+
+// specSchema is a schema with its $refs followed and its allOf members merged
+type specSchema struct {
+	// keywords are the merged keywords, e.g. type, maxLength, enum, properties and required
+	keywords map[string]interface{}
+	loc      specLocation
+	// properties are the locations of the merged properties, which may come from different allOf members
+	properties map[string]specLocation
+}
+
+// specVariant is one member of a polymorphic schema
+type specVariant struct {
+	// value is the discriminator value selecting the variant, e.g. "Basic"
+	value  string
+	schema specSchema
+}
+
+// document returns a spec file, relative to -specs-path
+func (r *specResolver) document(file string) (map[string]interface{}, error) {
+	if doc, ok := r.docs[file]; ok {
+		return doc, nil
+	}
+	raw, err := os.ReadFile(filepath.Join(SpecsPath, file))
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	r.docs[file] = doc
+	return doc, nil
+}
+
+// lookup returns the object at loc
+func (r *specResolver) lookup(loc specLocation) (map[string]interface{}, bool) {
+	doc, err := r.document(loc.file)
+	if err != nil {
+		return nil, false
+	}
+	var current interface{} = doc
+	if loc.pointer != "" {
+		for _, segment := range strings.Split(strings.TrimPrefix(loc.pointer, "/"), "/") {
+			segment = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+			switch node := current.(type) {
+			case map[string]interface{}:
+				current = node[segment]
+			case []interface{}:
+				i, err := strconv.Atoi(segment)
+				if err != nil || i < 0 || i >= len(node) {
+					return nil, false
+				}
+				current = node[i]
+			default:
+				return nil, false
+			}
+		}
+	}
+	node, ok := current.(map[string]interface{})
+	return node, ok
+}
+
+// resolve follows the $ref chain of node at loc, local refs resolving in the file that contains them
+func (r *specResolver) resolve(loc specLocation, node map[string]interface{}) (map[string]interface{}, specLocation) {
+	for i := 0; i < maxRefDepth; i++ {
+		ref, ok := node["$ref"].(string)
+		if !ok {
+			break
+		}
+		next := loc.follow(node)
+		target, ok := r.lookup(next)
+		if !ok {
+			fmt.Printf("  ⚠️  Could not resolve $ref %q in %s\n", ref, loc.file)
+			break
+		}
+		node, loc = target, next
+	}
+	return node, loc
+}
+
+// flatten resolves node and merges its allOf members, the schema's own keywords winning over its members'
+func (r *specResolver) flatten(loc specLocation, node map[string]interface{}) specSchema {
+	return r.flattenDepth(loc, node, 0)
+}
+
+func (r *specResolver) flattenDepth(loc specLocation, node map[string]interface{}, depth int) specSchema {
+	node, loc = r.resolve(loc, node)
+	s := specSchema{keywords: make(map[string]interface{}), loc: loc, properties: make(map[string]specLocation)}
+	properties := make(map[string]interface{})
+	var required []interface{}
+	addRequired := func(names []interface{}) {
+		for _, name := range names {
+			if !containsValue(required, name) {
+				required = append(required, name)
+			}
+		}
+	}
+
+	if members, ok := node["allOf"].([]interface{}); ok && depth < maxRefDepth {
+		for i, item := range members {
+			member, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			m := r.flattenDepth(specLocation{file: loc.file, pointer: fmt.Sprintf("%s/allOf/%d", loc.pointer, i)}, member, depth+1)
+			for key, value := range m.keywords {
+				switch key {
+				case "properties":
+					for name, property := range value.(map[string]interface{}) {
+						if _, exists := properties[name]; !exists {
+							properties[name] = property
+							s.properties[name] = m.properties[name]
+						}
+					}
+				case "required":
+					addRequired(value.([]interface{}))
+				default:
+					if _, exists := s.keywords[key]; !exists {
+						s.keywords[key] = value
+					}
+				}
+			}
+		}
+	}
+
+	for key, value := range node {
+		switch key {
+		case "allOf", "$ref":
+		case "properties":
+			if own, ok := value.(map[string]interface{}); ok {
+				for name, property := range own {
+					properties[name] = property
+					s.properties[name] = loc.property(name)
+				}
+			}
+		case "required":
+			if names, ok := value.([]interface{}); ok {
+				addRequired(names)
+			}
+		default:
+			s.keywords[key] = value
+		}
+	}
+	if len(properties) > 0 {
+		s.keywords["properties"] = properties
+	}
+	if len(required) > 0 {
+		s.keywords["required"] = required
+	}
+	normalizeNullable(s.keywords)
+	return s
+}
+
+// normalizeNullable reduces OpenAPI 3.1 type lists like ["string", "null"] to the single type and drops null from
+// enums, recording both, like OpenAPI 3.0 nullable and Swagger x-nullable, as nullable
+// Rules check values that are set, so a nullable attribute gets the same rule as a non-nullable one.
+func normalizeNullable(keywords map[string]interface{}) {
+	if types, ok := keywords["type"].([]interface{}); ok {
+		var nonNull []interface{}
+		for _, t := range types {
+			if t == "null" {
+				keywords["nullable"] = true
+			} else {
+				nonNull = append(nonNull, t)
+			}
+		}
+		if len(nonNull) == 1 {
+			keywords["type"] = nonNull[0]
+		}
+	}
+	if enum, ok := keywords["enum"].([]interface{}); ok && containsValue(enum, nil) {
+		var values []interface{}
+		for _, value := range enum {
+			if value != nil {
+				values = append(values, value)
+			}
+		}
+		keywords["enum"] = values
+		keywords["nullable"] = true
+	}
+	if fetchBool(keywords, "x-nullable") {
+		keywords["nullable"] = true
+	}
+}
+
+// arrayItems returns the item schema of an array schema s
+func (r *specResolver) arrayItems(s specSchema) (specSchema, bool) {
+	items, ok := s.keywords["items"].(map[string]interface{})
+	if !ok || fetchString(s.keywords, "type") != "array" {
+		return specSchema{}, false
+	}
+	return r.flatten(specLocation{file: s.loc.file, pointer: s.loc.pointer + "/items"}, items), true
+}
+
+// property returns the schema of property name of s, or of the first variant of s that has it
+func (r *specResolver) property(s specSchema, name string) (specSchema, bool) {
+	if property, ok := ownProperty(s, name); ok {
+		return r.flatten(s.properties[name], property), true
+	}
+	for _, variant := range r.variants(s) {
+		if property, ok := ownProperty(variant.schema, name); ok {
+			return r.flatten(variant.schema.properties[name], property), true
+		}
+	}
+	return specSchema{}, false
+}
+
+func ownProperty(s specSchema, name string) (map[string]interface{}, bool) {
+	properties, _ := s.keywords["properties"].(map[string]interface{})
+	property, ok := properties[name].(map[string]interface{})
+	return property, ok
+}
+
+// hasProperties reports whether s is an object schema with properties or variants
+func (r *specResolver) hasProperties(s specSchema) bool {
+	if _, ok := s.keywords["properties"]; ok {
+		return true
+	}
+	return len(r.variants(s)) > 0
+}
+
+// discriminatorProperty returns the property selecting the variant of s, from an OpenAPI 3 discriminator object or
+// a Swagger 2 discriminator name
+func discriminatorProperty(s specSchema) string {
+	switch d := s.keywords["discriminator"].(type) {
+	case string:
+		return d
+	case map[string]interface{}:
+		name, _ := d["propertyName"].(string)
+		return name
+	}
+	return ""
+}
+
+// variants returns the variants of a polymorphic schema: the members of oneOf/anyOf, or for a Swagger 2
+// discriminator the definitions whose allOf references s, e.g. BasicCredentials of Credentials
+func (r *specResolver) variants(s specSchema) []specVariant {
+	property := discriminatorProperty(s)
+
+	var variants []specVariant
+	for _, keyword := range []string{"oneOf", "anyOf"} {
+		members, ok := s.keywords[keyword].([]interface{})
+		if !ok {
+			continue
+		}
+		for i, item := range members {
+			member, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			ref, _ := member["$ref"].(string)
+			schema := r.flatten(specLocation{file: s.loc.file, pointer: fmt.Sprintf("%s/%s/%d", s.loc.pointer, keyword, i)}, member)
+			variants = append(variants, specVariant{value: variantValue(s, ref, schema, property), schema: schema})
+		}
+	}
+	if len(variants) > 0 || property == "" {
+		return variants
+	}
+
+	doc, err := r.document(s.loc.file)
+	if err != nil {
+		return nil
+	}
+	definitions, _ := doc["definitions"].(map[string]interface{})
+	names := make([]string, 0, len(definitions))
+	for name := range definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		definition, _ := definitions[name].(map[string]interface{})
+		members, _ := definition["allOf"].([]interface{})
+		loc := specLocation{file: s.loc.file, pointer: "/definitions/" + jsonPointerEscape(name)}
+		for i, item := range members {
+			member, ok := item.(map[string]interface{})
+			if !ok || member["$ref"] == nil {
+				continue
+			}
+			memberLoc := specLocation{file: loc.file, pointer: fmt.Sprintf("%s/allOf/%d", loc.pointer, i)}
+			if _, target := r.resolve(memberLoc, member); target != s.loc {
+				continue
+			}
+			value := fetchString(definition, "x-ms-discriminator-value")
+			if value == "" {
+				value = name
+			}
+			variants = append(variants, specVariant{value: value, schema: r.flatten(loc, definition)})
+			break
+		}
+	}
+	return variants
+}
+
+// variantValue returns the discriminator value of a oneOf/anyOf member: its key in the discriminator mapping, the
+// single enum or const value of its discriminator property, or else the name of the schema it references
+func variantValue(base specSchema, ref string, member specSchema, property string) string {
+	if d, ok := base.keywords["discriminator"].(map[string]interface{}); ok {
+		if mapping, ok := d["mapping"].(map[string]interface{}); ok {
+			values := make([]string, 0, len(mapping))
+			for value := range mapping {
+				values = append(values, value)
+			}
+			sort.Strings(values)
+			for _, value := range values {
+				if target, _ := mapping[value].(string); target == ref || (ref != "" && strings.HasSuffix(ref, "/"+target)) {
+					return value
+				}
+			}
+		}
+	}
+	if property, ok := ownProperty(member, property); ok {
+		if value := fetchString(property, "const"); value != "" {
+			return value
+		}
+		if enum := fetchStrings(property, "enum"); len(enum) == 1 {
+			return enum[0]
+		}
+	}
+	if ref != "" {
+		return ref[strings.LastIndex(ref, "/")+1:]
+	}
+	return ""
+}
+
+// jsonPointerEscape escapes a JSON pointer segment
+func jsonPointerEscape(segment string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(segment, "~", "~0"), "/", "~1")
+}
+
+func containsValue(values []interface{}, v interface{}) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+type schema struct {
+	ProviderSchema providerSchema `json:"provider_schemas"`
+}
+
+type providerSchema struct {
+	Fabric provider `json:"registry.terraform.io/microsoft/fabric"`
+}
+
+type provider struct {
+	ResourceSchemas   map[string]resourceSchema `json:"resource_schemas"`
+	DataSourceSchemas map[string]resourceSchema `json:"data_source_schemas"`
+}
+
+// schemaOf returns the schema of a resource, or of a data source when blockType is "data"
+func (p provider) schemaOf(blockType, name string) (resourceSchema, bool) {
+	if blockType == "data" {
+		rs, ok := p.DataSourceSchemas[name]
+		return rs, ok
+	}
+	rs, ok := p.ResourceSchemas[name]
+	return rs, ok
+}
+
+type resourceSchema struct {
+	Block block `json:"block"`
+}
+
+type block struct {
+	Attributes map[string]attribute      `json:"attributes"`
+	BlockTypes map[string]resourceSchema `json:"block_types"`
+}
+
+type attribute struct {
+	Type        interface{} `json:"type"`
+	Description string      `json:"description"`
+	Sensitive   bool        `json:"sensitive"`
+	Required    bool        `json:"required"`
+	Optional    bool        `json:"optional"`
+	Computed    bool        `json:"computed"`
+	NestedType  *nestedType `json:"nested_type"`
+}
+
+// nestedType describes attributes that hold objects, e.g. configuration = { ... }
+type nestedType struct {
+	Attributes  map[string]attribute `json:"attributes"`
+	NestingMode string               `json:"nesting_mode"`
+}
+
+func loadProviderSchema() provider {
+	schemaPath := getFullPath("schema/schema.json")
+	fmt.Println("Loading provider schema from:", schemaPath)
+	src, err := ioutil.ReadFile(schemaPath)
+
+	if err != nil {
+		panic(fmt.Sprintf("Failed to read schema file: %v\nPlease generate schema.json first:\n  cd %s/schema\n  terraform providers schema -json > schema.json", err, getFullPath("schema")))
+	}
+
+	var schema schema
+	if err := json.Unmarshal(src, &schema); err != nil {
+		panic(fmt.Sprintf("Failed to parse schema JSON: %v", err))
+	}
+	return schema.ProviderSchema.Fabric
+}
+
+// walkSchemaAttributes calls fn for every attribute of b, including attributes of nested blocks and nested objects
+// Nested attributes are passed with their dotted path, e.g. "target.onelake.path"
+func walkSchemaAttributes(b block, prefix string, fn func(path string, attr attribute)) {
+	for name, attr := range b.Attributes {
+		walkAttribute(prefix+name, attr, fn)
+	}
+	for name, blockType := range b.BlockTypes {
+		walkSchemaAttributes(blockType.Block, prefix+name+".", fn)
+	}
+}
+
+func walkAttribute(path string, attr attribute, fn func(path string, attr attribute)) {
+	fn(path, attr)
+	if attr.NestedType == nil {
+		return
+	}
+	for name, nested := range attr.NestedType.Attributes {
+		walkAttribute(path+"."+name, nested, fn)
+	}
+}
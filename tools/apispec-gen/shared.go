@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclparse"
+)
+
+// sharedConstraintsFile is a file of mappings/shared/, holding constraints that attribute mappings of several
+// resources refer to with shared = "<name>"
+type sharedConstraintsFile struct {
+	Shared []sharedConstraints `hcl:"shared,block"`
+}
+
+// sharedConstraints are the manual constraints of an attribute mapping, kept once for every attribute that uses them,
+// e.g. the SQL naming rules of warehouse and SQL database display names
+type sharedConstraints struct {
+	Name              string   `hcl:"name,label"`
+	MaxLength         *int     `hcl:"max_length,optional"`
+	MinLength         *int     `hcl:"min_length,optional"`
+	Pattern           *string  `hcl:"pattern,optional"`
+	PatternMessage    *string  `hcl:"pattern_message,optional"`
+	AllowedCharacters *string  `hcl:"allowed_characters,optional"`
+	ReservedWords     []string `hcl:"reserved_words,optional"`
+}
+
+// loadSharedConstraints reads the shared blocks of mappings/shared/*.hcl by name
+func loadSharedConstraints() (map[string]sharedConstraints, error) {
+	files, err := filepath.Glob(getFullPath("mappings/shared/*.hcl"))
+	if err != nil {
+		return nil, err
+	}
+
+	shared := make(map[string]sharedConstraints)
+	for _, file := range files {
+		f, diags := hclparse.NewParser().ParseHCLFile(file)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		var sf sharedConstraintsFile
+		if diags := gohcl.DecodeBody(f.Body, nil, &sf); diags.HasErrors() {
+			return nil, diags
+		}
+		for _, s := range sf.Shared {
+			if _, exists := shared[s.Name]; exists {
+				return nil, fmt.Errorf("%s: shared %q is already defined", file, s.Name)
+			}
+			shared[s.Name] = s
+		}
+	}
+	return shared, nil
+}
+
+// applySharedConstraints fills the constraints an attribute mapping leaves unset from the shared block it names,
+// returning the names of shared blocks that don't exist
+func applySharedConstraints(m *mapping, shared map[string]sharedConstraints) []string {
+	var unknown []string
+	for i := range m.Attributes {
+		attr := &m.Attributes[i]
+		if attr.Shared == nil {
+			continue
+		}
+		s, exists := shared[*attr.Shared]
+		if !exists {
+			unknown = append(unknown, fmt.Sprintf("%s.%s: %s", m.Resource, attr.Name, *attr.Shared))
+			continue
+		}
+
+		// Constraints set on the attribute win over the shared ones
+		if attr.MaxLength == nil {
+			attr.MaxLength = s.MaxLength
+		}
+		if attr.MinLength == nil {
+			attr.MinLength = s.MinLength
+		}
+		if attr.Pattern == nil {
+			attr.Pattern = s.Pattern
+		}
+		if attr.PatternMessage == nil {
+			attr.PatternMessage = s.PatternMessage
+		}
+		if attr.AllowedCharacters == nil {
+			attr.AllowedCharacters = s.AllowedCharacters
+		}
+		if len(attr.ReservedWords) == 0 {
+			attr.ReservedWords = s.ReservedWords
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
@@ -1,4 +1,4 @@
-module github.com/RuneORakeie/tflint-ruleset-fabric/tools/apispec-rule-gen
+module github.com/RuneORakeie/tflint-ruleset-fabric/tools
 
 go 1.25
 
@@ -6,13 +6,13 @@ require (
 	// Needed to build the plugin (generated rules, provider entry)
 	github.com/terraform-linters/tflint-plugin-sdk v0.23.1
 
-	// Needed by your rule-generator under tools/apispec-rule-gen
+	// Needed by the generator under tools/apispec-gen
 	github.com/hashicorp/hcl/v2 v2.19.1
 	github.com/zclconf/go-cty v1.14.1
 )
 
 require (
-	// Indirects used by the rule-generator
+	// Indirects used by the generator
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/google/go-cmp v0.6.0 // indirect